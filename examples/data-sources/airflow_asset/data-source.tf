@@ -0,0 +1,3 @@
+data "airflow_asset" "orders" {
+  uri = "postgres://warehouse/public/orders"
+}
@@ -0,0 +1,4 @@
+data "airflow_asset_events" "orders" {
+  uri   = "postgres://warehouse/public/orders"
+  limit = 10
+}
@@ -0,0 +1,8 @@
+data "airflow_assets" "warehouse" {
+  uri_pattern = "postgres://warehouse/"
+}
+
+# Every asset written by the platform must have at least one consumer.
+output "unconsumed_assets" {
+  value = [for a in data.airflow_assets.warehouse.assets : a.uri if length(a.consuming_dags) == 0]
+}
@@ -0,0 +1,23 @@
+locals {
+  required_connections = ["warehouse", "aws_default", "slack_alerts"]
+}
+
+data "airflow_connections" "all" {}
+
+resource "airflow_dag" "reporting" {
+  dag_id    = "reporting"
+  is_paused = false
+
+  lifecycle {
+    precondition {
+      condition     = length(setsubtract(local.required_connections, data.airflow_connections.all.connection_ids)) == 0
+      error_message = "Missing Airflow connections: ${join(", ", setsubtract(local.required_connections, data.airflow_connections.all.connection_ids))}."
+    }
+  }
+}
+
+# All Postgres connections whose ID contains "analytics".
+data "airflow_connections" "analytics_postgres" {
+  connection_id_pattern = "analytics"
+  conn_type             = "postgres"
+}
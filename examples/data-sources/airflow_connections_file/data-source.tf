@@ -0,0 +1,18 @@
+# Written by `airflow connections export connections.json` on the old environment.
+data "airflow_connections_file" "legacy" {
+  path = "${path.module}/connections.json"
+}
+
+resource "airflow_connection" "migrated" {
+  for_each = data.airflow_connections_file.legacy.connections
+
+  connection_id = each.key
+  conn_type     = each.value.conn_type
+  description   = each.value.description
+  host          = each.value.host
+  login         = each.value.login
+  password      = each.value.password
+  schema        = each.value.schema
+  port          = each.value.port
+  extra         = each.value.extra
+}
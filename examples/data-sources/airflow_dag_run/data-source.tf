@@ -0,0 +1,4 @@
+data "airflow_dag_run" "example" {
+  dag_id     = "example_bash_operator"
+  dag_run_id = "scheduled__2026-01-01T00:00:00+00:00"
+}
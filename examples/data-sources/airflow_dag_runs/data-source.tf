@@ -0,0 +1,16 @@
+data "airflow_dag_runs" "nightly" {
+  dag_id   = "nightly_build"
+  run_type = ["scheduled"]
+  state    = ["success", "failed"]
+  order_by = "-logical_date"
+  limit    = 1
+}
+
+resource "terraform_data" "promote" {
+  lifecycle {
+    precondition {
+      condition     = length(data.airflow_dag_runs.nightly.dag_runs) > 0 && data.airflow_dag_runs.nightly.dag_runs[0].state == "success"
+      error_message = "The last nightly build did not succeed."
+    }
+  }
+}
@@ -0,0 +1,5 @@
+data "airflow_task_instance" "load" {
+  dag_id     = "warehouse_load"
+  dag_run_id = "manual__2024-01-01T00:00:00+00:00"
+  task_id    = "load_orders"
+}
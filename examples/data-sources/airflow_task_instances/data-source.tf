@@ -0,0 +1,5 @@
+# Failed task instances of the last day, across all DAGs.
+data "airflow_task_instances" "failed" {
+  state          = ["failed", "upstream_failed"]
+  start_date_gte = timeadd(plantimestamp(), "-24h")
+}
@@ -0,0 +1,11 @@
+data "airflow_task_log" "bootstrap" {
+  dag_id       = "bootstrap"
+  dag_run_id   = airflow_dag_run.bootstrap.dag_run_id
+  task_id      = "create_schema"
+  full_content = true
+  tail_lines   = 100
+}
+
+output "bootstrap_log" {
+  value = data.airflow_task_log.bootstrap.content
+}
@@ -0,0 +1,14 @@
+resource "airflow_dag_run" "provision" {
+  dag_id = "provision_bucket"
+}
+
+data "airflow_xcom" "bucket" {
+  dag_id     = airflow_dag_run.provision.dag_id
+  dag_run_id = airflow_dag_run.provision.dag_run_id
+  task_id    = "create_bucket"
+  key        = "return_value"
+}
+
+output "bucket_name" {
+  value = data.airflow_xcom.bucket.value_json.name
+}
@@ -0,0 +1,4 @@
+data "airflow_xcoms" "provision" {
+  dag_id     = "provision_bucket"
+  dag_run_id = "manual__2024-01-01T00:00:00+00:00"
+}
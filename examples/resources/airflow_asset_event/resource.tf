@@ -0,0 +1,12 @@
+# Notify the DAGs scheduled on the warehouse table whenever it is rebuilt.
+resource "airflow_asset_event" "orders" {
+  uri = "postgres://warehouse/public/orders"
+
+  extra = jsonencode({
+    source = "terraform"
+  })
+
+  triggers = {
+    table_version = var.orders_table_version
+  }
+}
@@ -0,0 +1 @@
+terraform import airflow_backfill.example 1
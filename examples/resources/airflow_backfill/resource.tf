@@ -0,0 +1,11 @@
+resource "airflow_backfill" "example" {
+  dag_id             = "example"
+  from_date          = "2024-01-01T00:00:00Z"
+  to_date            = "2024-01-31T00:00:00Z"
+  reprocess_behavior = "failed"
+  max_active_runs    = 2
+
+  dag_run_conf = {
+    source = "backfill"
+  }
+}
@@ -2,3 +2,35 @@ resource "airflow_connection" "example" {
   connection_id = "example"
   conn_type     = "example"
 }
+
+# A connection migrated from an AIRFLOW_CONN_* environment variable.
+resource "airflow_connection" "warehouse" {
+  connection_id  = "warehouse"
+  uri_wo         = var.warehouse_connection_uri
+  uri_wo_version = "1"
+}
+
+# Non-secret extra keys stay visible in plans; the secret comes from a
+# sensitive variable and is never refreshed from Airflow. adopt_existing takes
+# over the aws_default connection that `airflow db migrate` creates.
+resource "airflow_connection" "aws" {
+  connection_id  = "aws_default"
+  conn_type      = "aws"
+  adopt_existing = true
+
+  extra_map = {
+    region_name           = "eu-west-1"
+    role_arn              = "arn:aws:iam::123456789012:role/airflow"
+    aws_secret_access_key = var.aws_secret_access_key
+  }
+  extra_sensitive_keys = ["aws_secret_access_key"]
+}
+
+# Fail the apply when Airflow cannot connect with the new settings. Requires
+# `[core] test_connection = Enabled` on the webserver.
+resource "airflow_connection" "api" {
+  connection_id = "partner_api"
+  conn_type     = "http"
+  host          = "https://api.example.com"
+  test_on_apply = "error"
+}
@@ -0,0 +1 @@
+terraform import airflow_connection_aws.example example
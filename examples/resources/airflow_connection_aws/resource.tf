@@ -0,0 +1,10 @@
+resource "airflow_connection_aws" "example" {
+  connection_id      = "aws_default"
+  region_name        = "eu-west-1"
+  role_arn           = "arn:aws:iam::123456789012:role/airflow"
+  assume_role_method = "assume_role"
+
+  config_kwargs = jsonencode({
+    retries = { max_attempts = 10, mode = "standard" }
+  })
+}
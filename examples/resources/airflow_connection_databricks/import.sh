@@ -0,0 +1 @@
+terraform import airflow_connection_databricks.example example
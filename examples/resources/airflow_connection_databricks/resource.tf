@@ -0,0 +1,7 @@
+resource "airflow_connection_databricks" "example" {
+  connection_id           = "databricks_default"
+  host                    = "https://adb-1234567890123456.7.azuredatabricks.net"
+  login                   = var.databricks_client_id
+  password                = var.databricks_client_secret
+  service_principal_oauth = true
+}
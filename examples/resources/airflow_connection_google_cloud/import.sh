@@ -0,0 +1 @@
+terraform import airflow_connection_google_cloud.example example
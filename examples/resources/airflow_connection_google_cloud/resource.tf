@@ -0,0 +1,6 @@
+resource "airflow_connection_google_cloud" "example" {
+  connection_id       = "google_cloud_default"
+  project             = "my-project"
+  impersonation_chain = "airflow@my-project.iam.gserviceaccount.com"
+  num_retries         = 5
+}
@@ -0,0 +1,5 @@
+resource "airflow_connection_kubernetes" "example" {
+  connection_id = "kubernetes_default"
+  in_cluster    = true
+  namespace     = "airflow-jobs"
+}
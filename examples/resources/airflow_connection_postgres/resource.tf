@@ -0,0 +1,10 @@
+resource "airflow_connection_postgres" "example" {
+  connection_id       = "warehouse"
+  host                = "db.example.com"
+  port                = 5432
+  schema              = "warehouse"
+  login               = "airflow"
+  password_wo         = var.warehouse_password
+  password_wo_version = "1"
+  sslmode             = "verify-full"
+}
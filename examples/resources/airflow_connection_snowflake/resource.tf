@@ -0,0 +1,9 @@
+resource "airflow_connection_snowflake" "example" {
+  connection_id       = "snowflake_default"
+  account             = "myorg-myaccount"
+  warehouse           = "TRANSFORMING"
+  database            = "ANALYTICS"
+  role                = "AIRFLOW"
+  login               = "AIRFLOW_SVC"
+  private_key_content = var.snowflake_private_key
+}
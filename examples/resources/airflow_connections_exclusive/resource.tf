@@ -0,0 +1,16 @@
+resource "airflow_connection" "team_a" {
+  for_each = {
+    team_a_warehouse = "postgres"
+    team_a_api       = "http"
+  }
+
+  connection_id = each.key
+  conn_type     = each.value
+}
+
+# Delete every other connection starting with "team_a_", e.g. ones created in
+# the UI.
+resource "airflow_connections_exclusive" "team_a" {
+  connection_id_prefix = "team_a_"
+  connection_ids       = [for c in airflow_connection.team_a : c.connection_id]
+}
@@ -0,0 +1,14 @@
+resource "airflow_dag" "example" {
+  dag_id = "example"
+}
+
+# Reparse the DAG file whenever its contents change.
+resource "airflow_dag_reparse" "example" {
+  file_token     = airflow_dag.example.file_token
+  dag_id         = airflow_dag.example.dag_id
+  wait_for_parse = true
+
+  triggers = {
+    source = filesha256("${path.module}/dags/example.py")
+  }
+}
@@ -0,0 +1,9 @@
+# Pause every DAG tagged "etl" for a maintenance window.
+resource "airflow_dags_paused" "maintenance" {
+  tags = ["etl"]
+}
+
+# Pause the DAGs whose ID contains "reporting_".
+resource "airflow_dags_paused" "reporting" {
+  dag_id_pattern = "reporting_"
+}
@@ -0,0 +1,5 @@
+# Delete the example connections created by `airflow db migrate`, except the
+# ones managed by Terraform.
+resource "airflow_default_connections" "this" {
+  keep = [airflow_connection.aws.connection_id]
+}
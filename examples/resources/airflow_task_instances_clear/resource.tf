@@ -0,0 +1,13 @@
+# Re-run the load task and everything downstream of it for January, after the
+# target table was fixed.
+resource "airflow_task_instances_clear" "reload" {
+  dag_id             = "warehouse_load"
+  start_date         = "2024-01-01T00:00:00Z"
+  end_date           = "2024-01-31T00:00:00Z"
+  task_ids           = ["load_orders"]
+  include_downstream = true
+
+  triggers = {
+    fix = "orders-schema-v2"
+  }
+}
@@ -19,6 +19,8 @@ import (
 type ProviderConfig struct {
 	ApiClient   *airflow.APIClient
 	AuthContext context.Context
+	// BasePath is the API base path the client was built with (e.g. /api/v1).
+	BasePath string
 }
 
 // NewProviderConfig builds the Airflow API client and auth context from the
@@ -81,5 +83,6 @@ func NewProviderConfig(endpoint, oauth2Token, username, password string, disable
 	return ProviderConfig{
 		ApiClient:   airflow.NewAPIClient(clientConf),
 		AuthContext: ctx,
+		BasePath:    basePath,
 	}, nil
 }
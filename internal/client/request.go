@@ -0,0 +1,126 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/apache/airflow-client-go/airflow"
+)
+
+// APIError is returned by Do for non-2xx responses. It keeps the response body
+// so callers can surface Airflow's own error message.
+type APIError struct {
+	Status string
+	body   []byte
+}
+
+func (e *APIError) Error() string {
+	return e.Status
+}
+
+// Body returns the raw response body.
+func (e *APIError) Body() []byte {
+	return e.body
+}
+
+// IsV2 reports whether the client targets the Airflow 3 REST API (/api/v2).
+func (c ProviderConfig) IsV2() bool {
+	return strings.HasSuffix(strings.TrimSuffix(c.BasePath, "/"), "/v2")
+}
+
+// WithAuth returns ctx carrying the auth values of AuthContext, so requests
+// made with it are authenticated but still honour ctx's cancellation.
+func (c ProviderConfig) WithAuth(ctx context.Context) context.Context {
+	if token, ok := c.AuthContext.Value(airflow.ContextAccessToken).(string); ok {
+		ctx = context.WithValue(ctx, airflow.ContextAccessToken, token)
+	}
+	if auth, ok := c.AuthContext.Value(airflow.ContextBasicAuth).(airflow.BasicAuth); ok {
+		ctx = context.WithValue(ctx, airflow.ContextBasicAuth, auth)
+	}
+	return ctx
+}
+
+// Do sends a JSON request to path (relative to the configured base path) for
+// endpoints the generated client does not cover, such as Airflow 3-only APIs.
+// It reuses the generated client's transport, default headers and auth, and
+// is cancelled with ctx. When out is non-nil a successful response body is
+// decoded into it.
+func (c ProviderConfig) Do(ctx context.Context, method, path string, query url.Values, body, out interface{}) (*http.Response, error) {
+	return c.do(ctx, c.ApiClient.GetConfig().Servers[0].URL+path, method, query, body, out)
+}
+
+// DoUI is Do for the private UI API that Airflow 3 serves under /ui next to
+// the REST API, for data the public API does not expose. Its endpoints are
+// not part of Airflow's stable API and may change between releases.
+func (c ProviderConfig) DoUI(ctx context.Context, method, path string, query url.Values, body, out interface{}) (*http.Response, error) {
+	root := strings.TrimSuffix(c.ApiClient.GetConfig().Servers[0].URL, strings.TrimSuffix(c.BasePath, "/"))
+	return c.do(ctx, root+"/ui"+path, method, query, body, out)
+}
+
+func (c ProviderConfig) do(ctx context.Context, rawURL, method string, query url.Values, body, out interface{}) (*http.Response, error) {
+	cfg := c.ApiClient.GetConfig()
+	ctx = c.WithAuth(ctx)
+
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	u.Scheme = cfg.Scheme
+	u.Host = cfg.Host
+	if len(query) > 0 {
+		u.RawQuery = query.Encode()
+	}
+
+	var reqBody io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		reqBody = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), reqBody)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if auth, ok := ctx.Value(airflow.ContextBasicAuth).(airflow.BasicAuth); ok {
+		req.SetBasicAuth(auth.UserName, auth.Password)
+	}
+	if token, ok := ctx.Value(airflow.ContextAccessToken).(string); ok {
+		req.Header.Add("Authorization", "Bearer "+token)
+	}
+	for k, v := range cfg.DefaultHeader {
+		req.Header.Add(k, v)
+	}
+
+	httpResp, err := cfg.HTTPClient.Do(req)
+	if err != nil {
+		return httpResp, err
+	}
+	defer httpResp.Body.Close()
+
+	respBody, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return httpResp, err
+	}
+	if httpResp.StatusCode >= 300 {
+		return httpResp, &APIError{Status: httpResp.Status, body: respBody}
+	}
+	if out != nil && len(bytes.TrimSpace(respBody)) > 0 {
+		if err := json.Unmarshal(respBody, out); err != nil {
+			return httpResp, fmt.Errorf("failed to decode response from %s: %w", u.Path, err)
+		}
+	}
+	return httpResp, nil
+}
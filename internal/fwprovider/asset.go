@@ -0,0 +1,270 @@
+package fwprovider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/drfaust92/terraform-provider-airflow/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Airflow 2 calls assets "datasets"; API v1 serves them under /datasets and
+// API v2 under /assets. The helpers below decode both spellings so callers do
+// not need to care which API version they talk to.
+
+// asset is an Airflow dataset (API v1) or asset (API v2).
+type asset struct {
+	ID             int64                  `json:"id"`
+	Name           string                 `json:"name"`
+	URI            string                 `json:"uri"`
+	Group          string                 `json:"group"`
+	Extra          map[string]interface{} `json:"extra"`
+	CreatedAt      string                 `json:"created_at"`
+	UpdatedAt      string                 `json:"updated_at"`
+	ConsumingDags  []assetDagReference    `json:"consuming_dags"`
+	ScheduledDags  []assetDagReference    `json:"scheduled_dags"`
+	ProducingTasks []assetTaskReference   `json:"producing_tasks"`
+	LastAssetEvent *struct {
+		Timestamp *string `json:"timestamp"`
+	} `json:"last_asset_event"`
+}
+
+type assetDagReference struct {
+	DagID string `json:"dag_id"`
+}
+
+type assetTaskReference struct {
+	DagID  string `json:"dag_id"`
+	TaskID string `json:"task_id"`
+}
+
+// consumingDags returns the IDs of the DAGs scheduled on the asset.
+func (a *asset) consumingDags() []string {
+	refs := a.ConsumingDags
+	if len(refs) == 0 {
+		refs = a.ScheduledDags
+	}
+
+	dagIDs := make([]string, 0, len(refs))
+	for _, ref := range refs {
+		dagIDs = append(dagIDs, ref.DagID)
+	}
+	return dagIDs
+}
+
+// assetEvent is an Airflow dataset (API v1) or asset (API v2) event.
+type assetEvent struct {
+	ID             int64                  `json:"id"`
+	DatasetID      int64                  `json:"dataset_id"`
+	AssetID        int64                  `json:"asset_id"`
+	DatasetURI     string                 `json:"dataset_uri"`
+	URI            string                 `json:"uri"`
+	Extra          map[string]interface{} `json:"extra"`
+	SourceDagID    *string                `json:"source_dag_id"`
+	SourceTaskID   *string                `json:"source_task_id"`
+	SourceRunID    *string                `json:"source_run_id"`
+	SourceMapIndex *int64                 `json:"source_map_index"`
+	Timestamp      string                 `json:"timestamp"`
+}
+
+// assetsPath returns the collection path for assets on the configured API.
+func assetsPath(cfg client.ProviderConfig) string {
+	if cfg.IsV2() {
+		return "/assets"
+	}
+	return "/datasets"
+}
+
+// getAssetByURI fetches the asset with the given URI. API v2 has no lookup by
+// URI, so the assets matching the URI are listed and the exact match picked.
+// It returns a nil asset and no error when the asset does not exist.
+func getAssetByURI(ctx context.Context, cfg client.ProviderConfig, uri string) (*asset, *http.Response, error) {
+	if !cfg.IsV2() {
+		var a asset
+		httpResp, err := cfg.Do(ctx, http.MethodGet, "/datasets/"+url.PathEscape(uri), nil, nil, &a)
+		if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+			return nil, httpResp, nil
+		}
+		if err != nil {
+			return nil, httpResp, err
+		}
+		return &a, httpResp, nil
+	}
+
+	assets, httpResp, err := listAssets(ctx, cfg, url.Values{"uri_pattern": {uri}})
+	if err != nil {
+		return nil, httpResp, err
+	}
+	for i := range assets {
+		if assets[i].URI == uri {
+			return &assets[i], httpResp, nil
+		}
+	}
+	return nil, httpResp, nil
+}
+
+// getAssetByName fetches the asset with the given name. Asset names only
+// exist on API v2. It returns a nil asset and no error when the asset does not
+// exist.
+func getAssetByName(ctx context.Context, cfg client.ProviderConfig, name string) (*asset, *http.Response, error) {
+	assets, httpResp, err := listAssets(ctx, cfg, url.Values{"name_pattern": {name}})
+	if err != nil {
+		return nil, httpResp, err
+	}
+	for i := range assets {
+		if assets[i].Name == name {
+			return &assets[i], httpResp, nil
+		}
+	}
+	return nil, httpResp, nil
+}
+
+// listAssets pages through the assets matching query.
+func listAssets(ctx context.Context, cfg client.ProviderConfig, query url.Values) ([]asset, *http.Response, error) {
+	// The collection is named after the path: datasets on API v1.
+	return listPages[asset](ctx, cfg, assetsPath(cfg), query, strings.TrimPrefix(assetsPath(cfg), "/"), nil, 0)
+}
+
+// createAssetEvent records an event for the asset a.
+func createAssetEvent(ctx context.Context, cfg client.ProviderConfig, a *asset, extra map[string]interface{}) (*assetEvent, *http.Response, error) {
+	body := map[string]interface{}{}
+	if cfg.IsV2() {
+		body["asset_id"] = a.ID
+	} else {
+		body["dataset_uri"] = a.URI
+	}
+	if extra != nil {
+		body["extra"] = extra
+	}
+
+	var event assetEvent
+	httpResp, err := cfg.Do(ctx, http.MethodPost, assetsPath(cfg)+"/events", nil, body, &event)
+	if err != nil {
+		return nil, httpResp, err
+	}
+	return &event, httpResp, nil
+}
+
+// listAssetEvents returns the most recent events of the asset a, newest first.
+func listAssetEvents(ctx context.Context, cfg client.ProviderConfig, a *asset, limit int) ([]assetEvent, *http.Response, error) {
+	idParam := "dataset_id"
+	if cfg.IsV2() {
+		idParam = "asset_id"
+	}
+
+	query := url.Values{}
+	query.Set(idParam, strconv.FormatInt(a.ID, 10))
+	query.Set("order_by", "-timestamp")
+	query.Set("limit", strconv.Itoa(limit))
+
+	var page struct {
+		DatasetEvents []assetEvent `json:"dataset_events"`
+		AssetEvents   []assetEvent `json:"asset_events"`
+	}
+	httpResp, err := cfg.Do(ctx, http.MethodGet, assetsPath(cfg)+"/events", query, nil, &page)
+	if err != nil {
+		return nil, httpResp, err
+	}
+	return append(page.DatasetEvents, page.AssetEvents...), httpResp, nil
+}
+
+// encodeExtra returns the JSON encoding of an asset or event extra, or "" when
+// it is empty.
+func encodeExtra(extra map[string]interface{}) (string, error) {
+	if len(extra) == 0 {
+		return "", nil
+	}
+	b, err := json.Marshal(extra)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// assetModel holds the asset attributes shared by the airflow_asset and
+// airflow_assets data sources.
+type assetModel struct {
+	AssetID        types.Int64  `tfsdk:"asset_id"`
+	URI            types.String `tfsdk:"uri"`
+	Name           types.String `tfsdk:"name"`
+	Group          types.String `tfsdk:"group"`
+	Extra          types.String `tfsdk:"extra"`
+	CreatedAt      types.String `tfsdk:"created_at"`
+	UpdatedAt      types.String `tfsdk:"updated_at"`
+	LastEventTime  types.String `tfsdk:"last_event_time"`
+	ConsumingDags  types.List   `tfsdk:"consuming_dags"`
+	ProducingTasks types.List   `tfsdk:"producing_tasks"`
+}
+
+var assetTaskAttrTypes = map[string]attr.Type{
+	"dag_id":  types.StringType,
+	"task_id": types.StringType,
+}
+
+var assetAttrTypes = map[string]attr.Type{
+	"asset_id":        types.Int64Type,
+	"uri":             types.StringType,
+	"name":            types.StringType,
+	"group":           types.StringType,
+	"extra":           types.StringType,
+	"created_at":      types.StringType,
+	"updated_at":      types.StringType,
+	"last_event_time": types.StringType,
+	"consuming_dags":  types.ListType{ElemType: types.StringType},
+	"producing_tasks": types.ListType{ElemType: types.ObjectType{AttrTypes: assetTaskAttrTypes}},
+}
+
+// toModel converts the asset into its Terraform attribute values. API v1 does
+// not report the latest event with the asset, so it is looked up separately.
+func (a *asset) toModel(ctx context.Context, cfg client.ProviderConfig, diags *diag.Diagnostics) assetModel {
+	lastEventTime := ""
+	if a.LastAssetEvent != nil {
+		lastEventTime = derefString(a.LastAssetEvent.Timestamp)
+	} else if !cfg.IsV2() {
+		events, httpResp, err := listAssetEvents(ctx, cfg, a, 1)
+		if err != nil {
+			diags.AddError("Failed to list Airflow asset events", clientError("list", a.URI, httpResp, err))
+		} else if len(events) > 0 {
+			lastEventTime = events[0].Timestamp
+		}
+	}
+
+	extra, err := encodeExtra(a.Extra)
+	if err != nil {
+		diags.AddError("Failed to encode Airflow asset extra", err.Error())
+	}
+
+	consumingDags, d := types.ListValueFrom(ctx, types.StringType, a.consumingDags())
+	diags.Append(d...)
+
+	tasks := make([]attr.Value, 0, len(a.ProducingTasks))
+	for _, t := range a.ProducingTasks {
+		v, d := types.ObjectValue(assetTaskAttrTypes, map[string]attr.Value{
+			"dag_id":  types.StringValue(t.DagID),
+			"task_id": types.StringValue(t.TaskID),
+		})
+		diags.Append(d...)
+		tasks = append(tasks, v)
+	}
+	producingTasks, d := types.ListValue(types.ObjectType{AttrTypes: assetTaskAttrTypes}, tasks)
+	diags.Append(d...)
+
+	return assetModel{
+		AssetID:        types.Int64Value(a.ID),
+		URI:            types.StringValue(a.URI),
+		Name:           types.StringValue(a.Name),
+		Group:          types.StringValue(a.Group),
+		Extra:          types.StringValue(extra),
+		CreatedAt:      types.StringValue(a.CreatedAt),
+		UpdatedAt:      types.StringValue(a.UpdatedAt),
+		LastEventTime:  types.StringValue(lastEventTime),
+		ConsumingDags:  consumingDags,
+		ProducingTasks: producingTasks,
+	}
+}
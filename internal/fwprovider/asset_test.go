@@ -0,0 +1,68 @@
+package fwprovider
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/drfaust92/terraform-provider-airflow/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+func TestAssetConsumingDags(t *testing.T) {
+	cases := []struct {
+		name, body string
+		want       int
+	}{
+		{"v1 consuming_dags", `{"consuming_dags":[{"dag_id":"a"},{"dag_id":"b"}]}`, 2},
+		{"v2 scheduled_dags", `{"scheduled_dags":[{"dag_id":"a"}]}`, 1},
+		{"none", `{}`, 0},
+	}
+	for _, c := range cases {
+		var a asset
+		if err := json.Unmarshal([]byte(c.body), &a); err != nil {
+			t.Fatalf("%s: %s", c.name, err)
+		}
+		if got := len(a.consumingDags()); got != c.want {
+			t.Errorf("%s: consumingDags() = %d DAGs, want %d", c.name, got, c.want)
+		}
+	}
+}
+
+func TestAssetToModel(t *testing.T) {
+	body := `{
+  "id": 7,
+  "name": "orders",
+  "uri": "s3://bucket/orders",
+  "group": "asset",
+  "extra": {"owner": "data"},
+  "producing_tasks": [{"dag_id": "producer", "task_id": "write"}],
+  "scheduled_dags": [{"dag_id": "consumer"}],
+  "last_asset_event": {"id": 3, "timestamp": "2026-01-02T00:00:00Z"}
+}`
+	var a asset
+	if err := json.Unmarshal([]byte(body), &a); err != nil {
+		t.Fatal(err)
+	}
+
+	var diags diag.Diagnostics
+	m := a.toModel(context.Background(), client.ProviderConfig{BasePath: "/api/v2"}, &diags)
+	if diags.HasError() {
+		t.Fatalf("toModel diagnostics: %+v", diags)
+	}
+	if got := m.AssetID.ValueInt64(); got != 7 {
+		t.Errorf("asset_id = %d, want 7", got)
+	}
+	if got := m.Extra.ValueString(); got != `{"owner":"data"}` {
+		t.Errorf("extra = %q", got)
+	}
+	if got := m.LastEventTime.ValueString(); got != "2026-01-02T00:00:00Z" {
+		t.Errorf("last_event_time = %q", got)
+	}
+	if got := len(m.ConsumingDags.Elements()); got != 1 {
+		t.Errorf("consuming_dags = %d elements, want 1", got)
+	}
+	if got := len(m.ProducingTasks.Elements()); got != 1 {
+		t.Errorf("producing_tasks = %d elements, want 1", got)
+	}
+}
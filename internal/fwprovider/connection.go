@@ -0,0 +1,87 @@
+package fwprovider
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/drfaust92/terraform-provider-airflow/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// connectionItem is a connection as listed by API v1 and v2, without the
+// password and extra.
+type connectionItem struct {
+	ConnectionID string  `json:"connection_id"`
+	ConnType     string  `json:"conn_type"`
+	Description  *string `json:"description"`
+	Host         *string `json:"host"`
+	Login        *string `json:"login"`
+	Schema       *string `json:"schema"`
+	Port         *int64  `json:"port"`
+	TeamName     *string `json:"team_name"`
+}
+
+// listConnections pages through the connections whose ID matches pattern
+// (all connections when empty), ordered by connection ID. API v1 cannot
+// filter on the connection ID, so pattern is applied to each page instead.
+func listConnections(ctx context.Context, cfg client.ProviderConfig, pattern string) ([]connectionItem, *http.Response, error) {
+	query := url.Values{"order_by": {"connection_id"}}
+	var keep func(connectionItem) bool
+	if pattern != "" {
+		if cfg.IsV2() {
+			query.Set("connection_id_pattern", pattern)
+		} else {
+			match := likeMatcher(pattern)
+			keep = func(c connectionItem) bool { return match(c.ConnectionID) }
+		}
+	}
+
+	conns, httpResp, err := listPages[connectionItem](ctx, cfg, "/connections", query, "connections", keep, 0)
+	if err != nil {
+		return nil, httpResp, err
+	}
+	// The API order depends on the database collation.
+	slices.SortFunc(conns, func(a, b connectionItem) int {
+		return strings.Compare(a.ConnectionID, b.ConnectionID)
+	})
+	return conns, httpResp, nil
+}
+
+// deleteConnections deletes the connections with the given IDs and returns
+// the IDs of those that are gone, including any already deleted.
+func deleteConnections(cfg client.ProviderConfig, ids []string, diags *diag.Diagnostics) []string {
+	var deleted []string
+	for _, id := range ids {
+		httpResp, err := cfg.ApiClient.ConnectionApi.DeleteConnection(cfg.AuthContext, id).Execute()
+		if err != nil && (httpResp == nil || httpResp.StatusCode != http.StatusNotFound) {
+			diags.AddError("Failed to delete Airflow connection", clientError("delete", id, httpResp, err))
+			continue
+		}
+		deleted = append(deleted, id)
+	}
+	return deleted
+}
+
+// likeMatcher returns a matcher for Airflow's `*_pattern` search parameters:
+// a case-insensitive substring match supporting the SQL `LIKE` wildcards `%`
+// and `_`.
+func likeMatcher(pattern string) func(string) bool {
+	var b strings.Builder
+	b.WriteString("(?is)")
+	for _, r := range pattern {
+		switch r {
+		case '%':
+			b.WriteString(".*")
+		case '_':
+			b.WriteString(".")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	re := regexp.MustCompile(b.String())
+	return re.MatchString
+}
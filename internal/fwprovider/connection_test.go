@@ -0,0 +1,24 @@
+package fwprovider
+
+import "testing"
+
+func TestLikeMatcher(t *testing.T) {
+	cases := []struct {
+		pattern, s string
+		want       bool
+	}{
+		{"aws", "aws_default", true},
+		{"AWS", "my_aws_conn", true},
+		{"aws%prod", "aws_eu_prod", true},
+		{"aws%prod", "prod_aws", false},
+		{"a_s", "aws", true},
+		{"a_s", "as", false},
+		{"a.b", "axb", false},
+		{"a.b", "a.b", true},
+	}
+	for _, c := range cases {
+		if got := likeMatcher(c.pattern)(c.s); got != c.want {
+			t.Errorf("likeMatcher(%q)(%q) = %t, want %t", c.pattern, c.s, got, c.want)
+		}
+	}
+}
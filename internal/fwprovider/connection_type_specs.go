@@ -0,0 +1,165 @@
+package fwprovider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// The typed connection resources. Their extra fields follow the connection
+// forms of the corresponding Airflow provider packages.
+
+func newAWSConnectionResource() resource.Resource {
+	return &typedConnectionResource{spec: awsConnectionSpec}
+}
+
+func newGoogleCloudConnectionResource() resource.Resource {
+	return &typedConnectionResource{spec: googleCloudConnectionSpec}
+}
+
+func newPostgresConnectionResource() resource.Resource {
+	return &typedConnectionResource{spec: postgresConnectionSpec}
+}
+
+func newSnowflakeConnectionResource() resource.Resource {
+	return &typedConnectionResource{spec: snowflakeConnectionSpec}
+}
+
+func newDatabricksConnectionResource() resource.Resource {
+	return &typedConnectionResource{spec: databricksConnectionSpec}
+}
+
+func newKubernetesConnectionResource() resource.Resource {
+	return &typedConnectionResource{spec: kubernetesConnectionSpec}
+}
+
+var awsConnectionSpec = &connectionTypeSpec{
+	typeName:    "connection_aws",
+	connType:    "aws",
+	description: "Provides an Airflow connection to Amazon Web Services, used by the `apache-airflow-providers-amazon` hooks.",
+	standard: map[string]string{
+		"login":    "The AWS access key ID. Leave unset to use the default credential chain of the workers.",
+		"password": "The AWS secret access key.",
+	},
+	extra: []connectionExtraField{
+		{key: "region_name", description: "The AWS region, e.g. `eu-west-1`."},
+		{key: "role_arn", description: "The ARN of an IAM role to assume."},
+		{
+			key:         "assume_role_method",
+			description: "How `role_arn` is assumed: `assume_role`, `assume_role_with_saml` or `assume_role_with_web_identity`.",
+			validators: []validator.String{
+				stringvalidator.OneOf("assume_role", "assume_role_with_saml", "assume_role_with_web_identity"),
+			},
+		},
+		{key: "assume_role_kwargs", kind: extraKindJSON, description: "Additional arguments of the STS call that assumes `role_arn`, e.g. `ExternalId`."},
+		{key: "aws_session_token", sensitive: true, description: "The session token of temporary credentials."},
+		{key: "profile_name", description: "The name of an AWS profile configured on the workers."},
+		{key: "endpoint_url", description: "A custom endpoint URL, e.g. for LocalStack or a VPC endpoint."},
+		{key: "config_kwargs", kind: extraKindJSON, description: "Arguments of the botocore client configuration, e.g. `jsonencode({ retries = { max_attempts = 10 } })`."},
+		{key: "session_kwargs", kind: extraKindJSON, description: "Additional arguments of the boto3 session."},
+	},
+}
+
+var googleCloudConnectionSpec = &connectionTypeSpec{
+	typeName:    "connection_google_cloud",
+	connType:    "google_cloud_platform",
+	description: "Provides an Airflow connection to Google Cloud, used by the `apache-airflow-providers-google` hooks.",
+	standard:    map[string]string{},
+	extra: []connectionExtraField{
+		{key: "project", description: "The default Google Cloud project ID."},
+		{key: "key_path", description: "The path of a service account key file on the workers."},
+		{key: "keyfile_dict", sensitive: true, description: "The content of a service account key file (JSON)."},
+		{key: "credential_config_file", description: "The path or content of a workload identity federation credential configuration file."},
+		{key: "key_secret_name", description: "The name of a Secret Manager secret holding the service account key."},
+		{key: "key_secret_project_id", description: "The project of `key_secret_name`, if different from `project`."},
+		{key: "scope", description: "A comma-separated list of OAuth scopes. Defaults to the cloud-platform scope."},
+		{key: "impersonation_chain", description: "A service account to impersonate, or a comma-separated chain of service accounts."},
+		{key: "num_retries", kind: extraKindInt64, description: "The number of times to retry failed API requests."},
+	},
+}
+
+var postgresConnectionSpec = &connectionTypeSpec{
+	typeName:    "connection_postgres",
+	connType:    "postgres",
+	description: "Provides an Airflow connection to PostgreSQL, used by the `apache-airflow-providers-postgres` hooks.",
+	standard: map[string]string{
+		"host":     "The database server host.",
+		"port":     "The database server port, `5432` if unset.",
+		"schema":   "The database name.",
+		"login":    "The database user.",
+		"password": "The password of the database user.",
+	},
+	extra: []connectionExtraField{
+		{
+			key:         "sslmode",
+			description: "The libpq SSL mode: `disable`, `allow`, `prefer`, `require`, `verify-ca` or `verify-full`.",
+			validators: []validator.String{
+				stringvalidator.OneOf("disable", "allow", "prefer", "require", "verify-ca", "verify-full"),
+			},
+		},
+		{key: "sslcert", description: "The path of the client certificate on the workers."},
+		{key: "sslkey", description: "The path of the client certificate key on the workers."},
+		{key: "sslrootcert", description: "The path of the root certificate(s) on the workers."},
+		{key: "sslcrl", description: "The path of the certificate revocation list on the workers."},
+		{key: "iam", kind: extraKindBool, description: "Authenticate with an IAM token of Amazon RDS or Redshift instead of the password."},
+		{key: "redshift", kind: extraKindBool, description: "Whether `iam` targets Amazon Redshift rather than RDS."},
+		{key: "aws_conn_id", description: "The AWS connection used to get IAM tokens."},
+		{key: "client_encoding", description: "The client encoding, e.g. `utf8`."},
+	},
+}
+
+var snowflakeConnectionSpec = &connectionTypeSpec{
+	typeName:    "connection_snowflake",
+	connType:    "snowflake",
+	description: "Provides an Airflow connection to Snowflake, used by the `apache-airflow-providers-snowflake` hooks.",
+	standard: map[string]string{
+		"schema":   "The default schema.",
+		"login":    "The Snowflake user.",
+		"password": "The password of the user, or the passphrase of its private key.",
+	},
+	extra: []connectionExtraField{
+		{key: "account", description: "The Snowflake account identifier."},
+		{key: "warehouse", description: "The default warehouse."},
+		{key: "database", description: "The default database."},
+		{key: "region", description: "The region of the account, for account identifiers without one."},
+		{key: "role", description: "The default role."},
+		{key: "authenticator", description: "The authenticator, e.g. `snowflake`, `externalbrowser`, `oauth` or an Okta URL."},
+		{key: "private_key_file", description: "The path of a private key file on the workers for key pair authentication."},
+		{key: "private_key_content", sensitive: true, description: "The content of a private key for key pair authentication."},
+		{key: "insecure_mode", kind: extraKindBool, description: "Turn off OCSP certificate checks."},
+	},
+}
+
+var databricksConnectionSpec = &connectionTypeSpec{
+	typeName:    "connection_databricks",
+	connType:    "databricks",
+	description: "Provides an Airflow connection to Databricks, used by the `apache-airflow-providers-databricks` hooks.",
+	standard: map[string]string{
+		"host":     "The workspace URL, e.g. `https://adb-1234567890123456.7.azuredatabricks.net`.",
+		"login":    "The user name, or the client ID of a service principal.",
+		"password": "The personal access token, the password of `login`, or the secret of a service principal.",
+	},
+	extra: []connectionExtraField{
+		{key: "service_principal_oauth", kind: extraKindBool, description: "Authenticate as the Databricks service principal `login` with OAuth."},
+		{key: "azure_tenant_id", description: "The Microsoft Entra tenant of an Azure service principal."},
+		{key: "azure_resource_id", description: "The Azure resource ID of the workspace, when the service principal is not a workspace user."},
+		{key: "use_azure_managed_identity", kind: extraKindBool, description: "Authenticate with the Azure managed identity of the workers."},
+	},
+}
+
+var kubernetesConnectionSpec = &connectionTypeSpec{
+	typeName:    "connection_kubernetes",
+	connType:    "kubernetes",
+	description: "Provides an Airflow connection to a Kubernetes cluster, used by the `apache-airflow-providers-cncf-kubernetes` hooks and operators.",
+	standard:    map[string]string{},
+	extra: []connectionExtraField{
+		{key: "in_cluster", kind: extraKindBool, description: "Use the service account of the pod Airflow runs in."},
+		{key: "kube_config_path", description: "The path of a kubeconfig file on the workers."},
+		{key: "kube_config", sensitive: true, description: "The content of a kubeconfig file (JSON or YAML)."},
+		{key: "cluster_context", description: "The kubeconfig context to use."},
+		{key: "namespace", description: "The default namespace."},
+		{key: "disable_verify_ssl", kind: extraKindBool, description: "Turn off verification of the API server certificate."},
+		{key: "disable_tcp_keepalive", kind: extraKindBool, description: "Turn off TCP keepalive on API server connections."},
+		{key: "xcom_sidecar_container_image", description: "The image of the XCom sidecar container."},
+	},
+}
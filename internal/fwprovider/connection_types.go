@@ -0,0 +1,175 @@
+package fwprovider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/drfaust92/terraform-provider-airflow/internal/client"
+)
+
+// builtinConnectionTypes are offered by Airflow's connection form on top of
+// the connection types of the installed provider packages.
+var builtinConnectionTypes = []string{"email", "fs", "generic", "mesos_framework-id"}
+
+// connectionTypes is the set of connection types known to the webserver.
+type connectionTypes struct {
+	names map[string]bool
+	// exact is false on API v1, which only lists the installed provider
+	// packages: names then holds the package names (e.g. "postgres" for
+	// apache-airflow-providers-postgres), which are only good for hints.
+	exact bool
+}
+
+type connectionTypesEntry struct {
+	mu    sync.Mutex
+	types *connectionTypes
+}
+
+// connectionTypesCache holds the connection types per API client, so they
+// are fetched once per provider instance. Failures are not cached, so a
+// transient error only skips the validation of the plan it happened in.
+var connectionTypesCache sync.Map // *airflow.APIClient -> *connectionTypesEntry
+
+// getConnectionTypes returns the connection types known to the webserver.
+func getConnectionTypes(ctx context.Context, cfg client.ProviderConfig) (*connectionTypes, error) {
+	v, _ := connectionTypesCache.LoadOrStore(cfg.ApiClient, &connectionTypesEntry{})
+	entry := v.(*connectionTypesEntry)
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+	if entry.types == nil {
+		types, err := fetchConnectionTypes(ctx, cfg)
+		if err != nil {
+			return nil, err
+		}
+		entry.types = types
+	}
+	return entry.types, nil
+}
+
+func fetchConnectionTypes(ctx context.Context, cfg client.ProviderConfig) (*connectionTypes, error) {
+	if cfg.IsV2() {
+		// Airflow 3 only lists the connection types of the installed
+		// providers on the UI API that backs its connection form.
+		var hooks []struct {
+			ConnectionType *string `json:"connection_type"`
+		}
+		if _, err := cfg.DoUI(ctx, http.MethodGet, "/connections/hook_meta", nil, nil, &hooks); err != nil {
+			return nil, err
+		}
+
+		ct := &connectionTypes{names: map[string]bool{}, exact: true}
+		for _, name := range builtinConnectionTypes {
+			ct.names[name] = true
+		}
+		for _, h := range hooks {
+			if h.ConnectionType != nil && *h.ConnectionType != "" {
+				ct.names[*h.ConnectionType] = true
+			}
+		}
+		return ct, nil
+	}
+
+	var res struct {
+		Providers []struct {
+			PackageName string `json:"package_name"`
+		} `json:"providers"`
+	}
+	if _, err := cfg.Do(ctx, http.MethodGet, "/providers", nil, nil, &res); err != nil {
+		return nil, err
+	}
+
+	ct := &connectionTypes{names: map[string]bool{}}
+	for _, name := range builtinConnectionTypes {
+		ct.names[name] = true
+	}
+	for _, p := range res.Providers {
+		name := strings.TrimPrefix(p.PackageName, "apache-airflow-providers-")
+		ct.names[strings.ReplaceAll(name, "-", "_")] = true
+		// e.g. microsoft-mssql also provides "mssql".
+		for _, part := range strings.Split(name, "-") {
+			ct.names[part] = true
+		}
+	}
+	return ct, nil
+}
+
+// validateConnType checks connType against the connection types known to the
+// webserver. It returns a diagnostic summary and detail when connType looks
+// wrong; isError is set when the webserver lists connection types exactly.
+// Lookup failures are logged and ignored, so an unreachable webserver or an
+// older Airflow without these endpoints never blocks a plan.
+func validateConnType(ctx context.Context, cfg client.ProviderConfig, connType string) (summary, detail string, isError bool) {
+	known, err := getConnectionTypes(ctx, cfg)
+	if err != nil {
+		log.Printf("[DEBUG] Skipping connection type validation: %s", err)
+		return "", "", false
+	}
+	if known.names[connType] {
+		return "", "", false
+	}
+
+	suggestion := closestName(connType, known.names)
+	if known.exact {
+		detail = fmt.Sprintf("Connection type %q is not provided by any provider package installed on the Airflow webserver.", connType)
+		if suggestion != "" {
+			detail += fmt.Sprintf(" Did you mean %q?", suggestion)
+		}
+		return "Unknown Airflow connection type", detail, true
+	}
+	if suggestion == "" {
+		// API v1 cannot list connection types, so only near misses of an
+		// installed provider package are reported.
+		return "", "", false
+	}
+	return "Possibly misspelled Airflow connection type",
+		fmt.Sprintf("Connection type %q is close to the installed provider package %[2]q. Did you mean %[2]q? The Airflow 2 API does not list connection types, so this is only a hint.", connType, suggestion),
+		false
+}
+
+// closestName returns the name closest to s by edit distance, if it is close
+// enough to be a likely typo.
+func closestName(s string, names map[string]bool) string {
+	maxDistance := 2
+	if len(s) > 8 {
+		maxDistance = 3
+	}
+
+	sorted := make([]string, 0, len(names))
+	for name := range names {
+		sorted = append(sorted, name)
+	}
+	sort.Strings(sorted)
+
+	best, bestDistance := "", maxDistance+1
+	for _, name := range sorted {
+		if d := levenshtein(s, name); d < bestDistance {
+			best, bestDistance = name, d
+		}
+	}
+	return best
+}
+
+func levenshtein(a, b string) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
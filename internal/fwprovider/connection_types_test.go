@@ -0,0 +1,52 @@
+package fwprovider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/drfaust92/terraform-provider-airflow/internal/client"
+)
+
+func TestValidateConnTypeV2(t *testing.T) {
+	var calls int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/ui/connections/hook_meta" {
+			t.Errorf("unexpected request %s %s", req.Method, req.URL.Path)
+			http.NotFound(w, req)
+			return
+		}
+		calls++
+		if calls == 1 {
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[{"connection_type": "http"}, {"connection_type": "postgres"}, {"connection_type": null}]`))
+	}))
+	defer srv.Close()
+
+	cfg, err := client.NewProviderConfig(srv.URL, "", "", "", false, "/api/v2", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	// A failed lookup skips validation without disabling it for good.
+	if summary, _, _ := validateConnType(ctx, cfg, "htp"); summary != "" {
+		t.Errorf("validateConnType() after a failed lookup = %q, want no diagnostic", summary)
+	}
+
+	summary, detail, isError := validateConnType(ctx, cfg, "htp")
+	if summary != "Unknown Airflow connection type" || !isError || !strings.Contains(detail, `Did you mean "http"?`) {
+		t.Errorf("validateConnType() = %q, %q, %t", summary, detail, isError)
+	}
+	if summary, _, _ := validateConnType(ctx, cfg, "postgres"); summary != "" {
+		t.Errorf("validateConnType(postgres) = %q, want no diagnostic", summary)
+	}
+	if calls != 2 {
+		t.Errorf("hook_meta was requested %d times, want 2", calls)
+	}
+}
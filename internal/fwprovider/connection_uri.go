@@ -0,0 +1,332 @@
+package fwprovider
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// connectionExtraKey is the query parameter Airflow uses to carry an extra
+// that cannot be expressed as plain query parameters.
+const connectionExtraKey = "__extra__"
+
+// connectionURI holds the fields of an Airflow connection that are encoded in
+// its URI (AIRFLOW_CONN_* format). Empty strings and a zero port mean unset.
+type connectionURI struct {
+	ConnType string
+	Host     string
+	Login    string
+	Password string
+	Schema   string
+	Port     int64
+	Extra    string
+}
+
+// parseConnectionURI parses an Airflow connection URI following the rules of
+// Airflow's Connection._parse_from_uri: the scheme is the connection type,
+// a second scheme is kept as a protocol prefix of the host
+// (`http://https://example.com`), all components are URL-decoded, and the
+// query string becomes the extra, either verbatim from `__extra__` or as a
+// JSON object of the parameters.
+func parseConnectionURI(uri string) (*connectionURI, error) {
+	// The URI is not quoted in errors: it usually contains a password.
+	switch strings.Count(uri, "://") {
+	case 1, 2:
+	default:
+		return nil, fmt.Errorf("invalid connection URI: expected one or two \"://\" separators, found %d", strings.Count(uri, "://"))
+	}
+
+	i := strings.Index(uri, "://")
+	connType := uri[:i]
+	if !isURIScheme(connType) {
+		return nil, fmt.Errorf("invalid connection URI: %q is not a valid connection type", connType)
+	}
+	rest := uri[i+len("://"):]
+
+	var protocol string
+	if j := strings.Index(rest, "://"); j >= 0 {
+		protocol = rest[:j]
+		if strings.ContainsAny(protocol, "@:") || !isURIScheme(protocol) {
+			return nil, fmt.Errorf("invalid connection URI: the host protocol must directly follow the connection type")
+		}
+		protocol = strings.ToLower(protocol)
+		rest = rest[j+len("://"):]
+	}
+
+	// Python's urlsplit: the fragment is cut first, then the query, and the
+	// network location ends at the first '/'.
+	if j := strings.IndexByte(rest, '#'); j >= 0 {
+		rest = rest[:j]
+	}
+	var query string
+	if j := strings.IndexByte(rest, '?'); j >= 0 {
+		rest, query = rest[:j], rest[j+1:]
+	}
+	netloc, path := rest, ""
+	if j := strings.IndexByte(rest, '/'); j >= 0 {
+		netloc, path = rest[:j], rest[j:]
+	}
+
+	c := &connectionURI{ConnType: normalizeConnType(strings.ToLower(connType))}
+
+	hostinfo := netloc
+	if j := strings.LastIndexByte(netloc, '@'); j >= 0 {
+		userinfo := netloc[:j]
+		hostinfo = netloc[j+1:]
+		login, password, _ := strings.Cut(userinfo, ":")
+		c.Login = pyUnquote(login)
+		c.Password = pyUnquote(password)
+	}
+
+	hostname, port := hostinfo, ""
+	if strings.HasPrefix(hostinfo, "[") {
+		if j := strings.IndexByte(hostinfo, ']'); j >= 0 {
+			hostname = hostinfo[1:j]
+			port = strings.TrimPrefix(hostinfo[j+1:], ":")
+		}
+	} else {
+		hostname, port, _ = strings.Cut(hostinfo, ":")
+	}
+	host := pyUnquote(strings.ToLower(hostname))
+	if strings.Contains(host, "/") {
+		// A URL-encoded '/' (e.g. a socket path): Airflow keeps the original
+		// case of the host in that case.
+		host = pyUnquote(hostname)
+	}
+	if protocol != "" {
+		host = protocol + "://" + host
+	}
+	c.Host = host
+
+	if port != "" {
+		p, err := strconv.ParseUint(port, 10, 16)
+		if err != nil {
+			return nil, fmt.Errorf("invalid connection URI: invalid port %q", port)
+		}
+		c.Port = int64(p)
+	}
+
+	if len(path) > 1 {
+		c.Schema = pyUnquote(path[1:])
+	}
+
+	if query != "" {
+		params := parseQuery(query)
+		if v, ok := params.get(connectionExtraKey); ok {
+			c.Extra = v
+		} else {
+			c.Extra = params.json()
+		}
+	}
+
+	return c, nil
+}
+
+// String renders the connection URI following Airflow's Connection.get_uri.
+func (c *connectionURI) String() string {
+	var b strings.Builder
+	if c.ConnType != "" {
+		b.WriteString(strings.ReplaceAll(strings.ToLower(c.ConnType), "_", "-") + "://")
+	} else {
+		b.WriteString("//")
+	}
+
+	host := c.Host
+	if protocol, h, ok := strings.Cut(c.Host, "://"); ok {
+		b.WriteString(protocol + "://")
+		host = h
+	}
+
+	authority := ""
+	if c.Login != "" {
+		authority += pyQuote(c.Login)
+	}
+	if c.Password != "" {
+		authority += ":" + pyQuote(c.Password)
+	}
+	if authority != "" {
+		b.WriteString(authority + "@")
+	}
+
+	hostBlock := ""
+	if host != "" {
+		hostBlock += pyQuote(host)
+	}
+	if c.Port != 0 {
+		if hostBlock == "" && authority == "" {
+			hostBlock += "@"
+		}
+		hostBlock += ":" + strconv.FormatInt(c.Port, 10)
+	}
+	if c.Schema != "" {
+		hostBlock += "/" + pyQuote(c.Schema)
+	}
+	b.WriteString(hostBlock)
+
+	if c.Extra != "" {
+		if c.Schema != "" {
+			b.WriteString("?")
+		} else {
+			b.WriteString("/?")
+		}
+		if params, ok := extraQueryParams(c.Extra); ok && len(params) > 0 {
+			b.WriteString(params.encode())
+		} else {
+			b.WriteString(connectionExtraKey + "=" + url.QueryEscape(c.Extra))
+		}
+	}
+
+	return b.String()
+}
+
+// normalizeConnType mirrors Connection._normalize_conn_type.
+func normalizeConnType(connType string) string {
+	if connType == "postgresql" {
+		return "postgres"
+	}
+	return strings.ReplaceAll(connType, "-", "_")
+}
+
+func isURIScheme(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i, r := range s {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z':
+		case i > 0 && (r >= '0' && r <= '9' || r == '+' || r == '-' || r == '.'):
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// pyQuote is Python's urllib.parse.quote(s, safe="").
+func pyQuote(s string) string {
+	return strings.ReplaceAll(url.QueryEscape(s), "+", "%20")
+}
+
+// pyUnquote is Python's urllib.parse.unquote: invalid escapes are kept as-is.
+func pyUnquote(s string) string {
+	if !strings.Contains(s, "%") {
+		return s
+	}
+	var b bytes.Buffer
+	for i := 0; i < len(s); i++ {
+		if s[i] == '%' && i+2 < len(s) && isHex(s[i+1]) && isHex(s[i+2]) {
+			v, _ := strconv.ParseUint(s[i+1:i+3], 16, 8)
+			b.WriteByte(byte(v))
+			i += 2
+			continue
+		}
+		b.WriteByte(s[i])
+	}
+	return b.String()
+}
+
+func isHex(c byte) bool {
+	return c >= '0' && c <= '9' || c >= 'a' && c <= 'f' || c >= 'A' && c <= 'F'
+}
+
+// queryParams is an ordered list of query parameters with unique keys, like
+// the dict Airflow builds from a query string.
+type queryParams [][2]string
+
+// parseQuery is dict(parse_qsl(query, keep_blank_values=True)): later values
+// replace earlier ones while keeping the position of the first occurrence.
+func parseQuery(query string) queryParams {
+	var params queryParams
+	index := map[string]int{}
+	for _, pair := range strings.Split(query, "&") {
+		if pair == "" {
+			continue
+		}
+		k, v, _ := strings.Cut(pair, "=")
+		k = pyUnquote(strings.ReplaceAll(k, "+", " "))
+		v = pyUnquote(strings.ReplaceAll(v, "+", " "))
+		if i, ok := index[k]; ok {
+			params[i][1] = v
+			continue
+		}
+		index[k] = len(params)
+		params = append(params, [2]string{k, v})
+	}
+	return params
+}
+
+func (p queryParams) get(key string) (string, bool) {
+	for _, kv := range p {
+		if kv[0] == key {
+			return kv[1], true
+		}
+	}
+	return "", false
+}
+
+// json encodes the parameters as a JSON object in their original order.
+func (p queryParams) json() string {
+	var b bytes.Buffer
+	b.WriteByte('{')
+	for i, kv := range p {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		k, _ := json.Marshal(kv[0])
+		v, _ := json.Marshal(kv[1])
+		b.Write(k)
+		b.WriteByte(':')
+		b.Write(v)
+	}
+	b.WriteByte('}')
+	return b.String()
+}
+
+// encode is Python's urllib.parse.urlencode.
+func (p queryParams) encode() string {
+	parts := make([]string, 0, len(p))
+	for _, kv := range p {
+		parts = append(parts, url.QueryEscape(kv[0])+"="+url.QueryEscape(kv[1]))
+	}
+	return strings.Join(parts, "&")
+}
+
+// extraQueryParams returns the parameters of a JSON object extra whose values
+// are all strings, in document order. Any other extra cannot round-trip
+// through plain query parameters, so Airflow encodes it in `__extra__`.
+func extraQueryParams(extra string) (queryParams, bool) {
+	dec := json.NewDecoder(strings.NewReader(extra))
+	if tok, err := dec.Token(); err != nil || tok != json.Delim('{') {
+		return nil, false
+	}
+
+	var params queryParams
+	seen := map[string]bool{}
+	for dec.More() {
+		kt, err := dec.Token()
+		if err != nil {
+			return nil, false
+		}
+		vt, err := dec.Token()
+		if err != nil {
+			return nil, false
+		}
+		k, _ := kt.(string)
+		v, ok := vt.(string)
+		if !ok || seen[k] {
+			return nil, false
+		}
+		seen[k] = true
+		params = append(params, [2]string{k, v})
+	}
+	if tok, err := dec.Token(); err != nil || tok != json.Delim('}') {
+		return nil, false
+	}
+	if _, err := dec.Token(); err == nil {
+		return nil, false
+	}
+	return params, true
+}
@@ -0,0 +1,218 @@
+package fwprovider
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	connectionsFileJSON = "json"
+	connectionsFileYAML = "yaml"
+	connectionsFileEnv  = "env"
+)
+
+// exportedConnection is a connection read from an `airflow connections
+// export` file.
+type exportedConnection struct {
+	connectionURI
+	Description string
+}
+
+// connectionsFileFormat returns the format of a connections file from its
+// extension, like the Airflow CLI does, or "" when it is not recognized.
+func connectionsFileFormat(path string) string {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		return connectionsFileJSON
+	case ".yaml", ".yml":
+		return connectionsFileYAML
+	case ".env":
+		return connectionsFileEnv
+	}
+	return ""
+}
+
+// sniffConnectionsFileFormat guesses the format of connections file content:
+// a JSON object, `conn_id=value` lines, or otherwise YAML.
+func sniffConnectionsFileFormat(content []byte) string {
+	trimmed := bytes.TrimSpace(content)
+	if bytes.HasPrefix(trimmed, []byte("{")) {
+		return connectionsFileJSON
+	}
+	scanner := bufio.NewScanner(bytes.NewReader(trimmed))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		key, _, ok := strings.Cut(line, "=")
+		if !ok || key == "" || strings.ContainsAny(key, ": \t") {
+			return connectionsFileYAML
+		}
+	}
+	return connectionsFileEnv
+}
+
+// parseConnectionsFile parses the connections of an `airflow connections
+// export` file in the given format. Like Airflow's local filesystem secrets
+// backend, a connection may be given as an object of its fields or as a
+// connection URI, in every format. Errors never include connection values,
+// which usually contain secrets.
+func parseConnectionsFile(content []byte, format string) (map[string]exportedConnection, error) {
+	var raw map[string]interface{}
+	switch format {
+	case connectionsFileJSON:
+		if err := json.Unmarshal(content, &raw); err != nil {
+			return nil, fmt.Errorf("invalid JSON connections file: %s", err)
+		}
+	case connectionsFileYAML:
+		if err := yaml.Unmarshal(content, &raw); err != nil {
+			var typeErr *yaml.TypeError
+			if errors.As(err, &typeErr) {
+				// Type errors quote the offending values.
+				return nil, fmt.Errorf("invalid YAML connections file: expected a mapping of connection IDs to connections")
+			}
+			return nil, fmt.Errorf("invalid YAML connections file: %s", err)
+		}
+	case connectionsFileEnv:
+		var err error
+		if raw, err = parseConnectionsEnv(content); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unsupported connections file format %q", format)
+	}
+
+	conns := make(map[string]exportedConnection, len(raw))
+	for id, v := range raw {
+		conn, err := exportedConnectionFrom(v)
+		if err != nil {
+			return nil, fmt.Errorf("connection %q: %s", id, err)
+		}
+		conns[id] = conn
+	}
+	return conns, nil
+}
+
+// parseConnectionsEnv parses `conn_id=value` lines, where the value is a
+// connection URI or a JSON object. Blank lines and comments are skipped.
+func parseConnectionsEnv(content []byte) (map[string]interface{}, error) {
+	raw := map[string]interface{}{}
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	scanner.Buffer(nil, 1024*1024)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		id, value, ok := strings.Cut(line, "=")
+		id = strings.TrimSpace(id)
+		if !ok || id == "" {
+			return nil, fmt.Errorf("invalid env connections file: line %d is not a conn_id=value pair", n)
+		}
+		if _, dup := raw[id]; dup {
+			return nil, fmt.Errorf("invalid env connections file: connection %q is defined more than once", id)
+		}
+		value = strings.TrimSpace(value)
+		if strings.HasPrefix(value, "{") {
+			var obj map[string]interface{}
+			if err := json.Unmarshal([]byte(value), &obj); err != nil {
+				return nil, fmt.Errorf("invalid env connections file: connection %q is not valid JSON: %s", id, err)
+			}
+			raw[id] = obj
+			continue
+		}
+		raw[id] = value
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("invalid env connections file: %s", err)
+	}
+	return raw, nil
+}
+
+// exportedConnectionFrom converts a decoded connection, a URI string or an
+// object of connection fields, into an exportedConnection.
+func exportedConnectionFrom(v interface{}) (exportedConnection, error) {
+	switch v := v.(type) {
+	case string:
+		u, err := parseConnectionURI(v)
+		if err != nil {
+			return exportedConnection{}, err
+		}
+		return exportedConnection{connectionURI: *u}, nil
+	case map[string]interface{}:
+		return exportedConnectionFromFields(v)
+	default:
+		return exportedConnection{}, fmt.Errorf("expected a connection URI or an object of connection fields")
+	}
+}
+
+func exportedConnectionFromFields(fields map[string]interface{}) (exportedConnection, error) {
+	var c exportedConnection
+	var err error
+	str := func(key string) string {
+		if err != nil {
+			return ""
+		}
+		switch v := fields[key].(type) {
+		case nil:
+			return ""
+		case string:
+			return v
+		case bool, int, int64, float64:
+			return fmt.Sprint(v)
+		default:
+			err = fmt.Errorf("%s must be a string", key)
+			return ""
+		}
+	}
+
+	c.ConnType = str("conn_type")
+	c.Description = str("description")
+	c.Host = str("host")
+	c.Login = str("login")
+	c.Password = str("password")
+	c.Schema = str("schema")
+	if err != nil {
+		return c, err
+	}
+
+	switch port := fields["port"].(type) {
+	case nil:
+	case int:
+		c.Port = int64(port)
+	case float64:
+		c.Port = int64(port)
+	case string:
+		if port != "" {
+			if c.Port, err = strconv.ParseInt(port, 10, 64); err != nil {
+				return c, fmt.Errorf("port must be a number")
+			}
+		}
+	default:
+		return c, fmt.Errorf("port must be a number")
+	}
+
+	// Airflow 2 exports the extra as a JSON string, Airflow 3 as an object.
+	switch extra := fields["extra"].(type) {
+	case nil:
+	case string:
+		c.Extra = extra
+	default:
+		b, err := json.Marshal(extra)
+		if err != nil {
+			return c, fmt.Errorf("extra cannot be encoded as JSON: %s", err)
+		}
+		c.Extra = string(b)
+	}
+
+	return c, nil
+}
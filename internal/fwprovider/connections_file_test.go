@@ -0,0 +1,99 @@
+package fwprovider
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseConnectionsFile(t *testing.T) {
+	want := map[string]exportedConnection{
+		"pg": {
+			connectionURI: connectionURI{ConnType: "postgres", Host: "db", Login: "user", Password: "p@ss", Schema: "warehouse", Port: 5432, Extra: `{"sslmode": "require"}`},
+			Description:   "Warehouse",
+		},
+		"api": {connectionURI: connectionURI{ConnType: "http", Host: "https://api.example.com"}},
+	}
+
+	cases := map[string]struct {
+		format  string
+		content string
+	}{
+		"json": {connectionsFileJSON, `{
+  "pg": {"conn_type": "postgres", "description": "Warehouse", "host": "db", "login": "user", "password": "p@ss", "schema": "warehouse", "port": 5432, "extra": "{\"sslmode\": \"require\"}"},
+  "api": {"conn_type": "http", "description": null, "host": "https://api.example.com", "login": null, "password": null, "schema": null, "port": null, "extra": null}
+}`},
+		"yaml": {connectionsFileYAML, `
+pg:
+  conn_type: postgres
+  description: Warehouse
+  host: db
+  login: user
+  password: p@ss
+  schema: warehouse
+  port: 5432
+  extra: '{"sslmode": "require"}'
+api: http://https://api.example.com
+`},
+		"env uri": {connectionsFileEnv, `
+# exported connections
+pg=postgres://user:p%40ss@db:5432/warehouse?__extra__=%7B%22sslmode%22%3A+%22require%22%7D
+api=http://https://api.example.com
+`},
+		"env json": {connectionsFileEnv, `pg={"conn_type": "postgres", "description": "Warehouse", "host": "db", "login": "user", "password": "p@ss", "schema": "warehouse", "port": 5432, "extra": "{\"sslmode\": \"require\"}"}
+api={"conn_type": "http", "host": "https://api.example.com"}
+`},
+	}
+	for name, c := range cases {
+		got, err := parseConnectionsFile([]byte(c.content), c.format)
+		if err != nil {
+			t.Errorf("%s: %s", name, err)
+			continue
+		}
+		if name == "env uri" {
+			// The description cannot be expressed in a URI.
+			got["pg"] = exportedConnection{connectionURI: got["pg"].connectionURI, Description: "Warehouse"}
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("%s: got %+v, want %+v", name, got, want)
+		}
+	}
+}
+
+func TestParseConnectionsFileExtraObject(t *testing.T) {
+	got, err := parseConnectionsFile([]byte(`{"aws": {"conn_type": "aws", "extra": {"region_name": "eu-west-1"}}}`), connectionsFileJSON)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if extra := got["aws"].Extra; extra != `{"region_name":"eu-west-1"}` {
+		t.Errorf("extra = %s", extra)
+	}
+}
+
+func TestParseConnectionsFileInvalid(t *testing.T) {
+	for name, c := range map[string]struct{ format, content string }{
+		"json":      {connectionsFileJSON, `{"pg": `},
+		"yaml list": {connectionsFileYAML, "- secret"},
+		"env":       {connectionsFileEnv, "no separator"},
+		"env dup":   {connectionsFileEnv, "a=http://x\na=http://y"},
+		"port":      {connectionsFileJSON, `{"pg": {"conn_type": "postgres", "port": "abc"}}`},
+		"uri":       {connectionsFileJSON, `{"pg": "not a uri"}`},
+	} {
+		if _, err := parseConnectionsFile([]byte(c.content), c.format); err == nil {
+			t.Errorf("%s: parse succeeded, want error", name)
+		}
+	}
+}
+
+func TestSniffConnectionsFileFormat(t *testing.T) {
+	for content, want := range map[string]string{
+		`{"a": {}}`:                       connectionsFileJSON,
+		"# comment\na=http://host\n":      connectionsFileEnv,
+		"a:\n  conn_type: http\n":         connectionsFileYAML,
+		"a: http://host?x=1\n":            connectionsFileYAML,
+		"a={\"conn_type\": \"http\"}\n\n": connectionsFileEnv,
+	} {
+		if got := sniffConnectionsFileFormat([]byte(content)); got != want {
+			t.Errorf("sniffConnectionsFileFormat(%q) = %q, want %q", content, got, want)
+		}
+	}
+}
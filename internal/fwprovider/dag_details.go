@@ -0,0 +1,225 @@
+package fwprovider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/apache/airflow-client-go/airflow"
+
+	"github.com/drfaust92/terraform-provider-airflow/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// dagDetailsModel holds the DAG details attributes shared by the airflow_dag
+// resource and data source.
+type dagDetailsModel struct {
+	Tags                 types.List   `tfsdk:"tags"`
+	Owners               types.List   `tfsdk:"owners"`
+	ScheduleInterval     types.String `tfsdk:"schedule_interval"`
+	TimetableDescription types.String `tfsdk:"timetable_description"`
+	NextDagrun           types.String `tfsdk:"next_dagrun"`
+	MaxActiveRuns        types.Int64  `tfsdk:"max_active_runs"`
+	HasImportErrors      types.Bool   `tfsdk:"has_import_errors"`
+	LastParsedTime       types.String `tfsdk:"last_parsed_time"`
+	DefaultView          types.String `tfsdk:"default_view"`
+	Params               types.String `tfsdk:"params"`
+}
+
+// dagDetails is the subset of the DAG details endpoint the provider exposes. It
+// is decoded directly rather than through the generated client, whose
+// DAGDetail model cannot decode API v2 responses (e.g. dag_run_timeout is an
+// ISO 8601 string there). Fields renamed in API v2 are listed under both names.
+type dagDetails struct {
+	Owners []string `json:"owners"`
+	Tags   []struct {
+		Name string `json:"name"`
+	} `json:"tags"`
+	ScheduleInterval      json.RawMessage        `json:"schedule_interval"`
+	TimetableSummary      string                 `json:"timetable_summary"`
+	TimetableDescription  string                 `json:"timetable_description"`
+	NextDagrun            string                 `json:"next_dagrun"`
+	NextDagrunLogicalDate string                 `json:"next_dagrun_logical_date"`
+	MaxActiveRuns         int64                  `json:"max_active_runs"`
+	HasImportErrors       bool                   `json:"has_import_errors"`
+	LastParsedTime        string                 `json:"last_parsed_time"`
+	DefaultView           string                 `json:"default_view"`
+	Params                map[string]interface{} `json:"params"`
+}
+
+// getDagDetails fetches the details of the DAG dagID.
+func getDagDetails(ctx context.Context, cfg client.ProviderConfig, dagID string) (*dagDetails, *http.Response, error) {
+	var details dagDetails
+	httpResp, err := cfg.Do(ctx, http.MethodGet, fmt.Sprintf("/dags/%s/details", url.PathEscape(dagID)), nil, nil, &details)
+	if err != nil {
+		return nil, httpResp, err
+	}
+	return &details, httpResp, nil
+}
+
+// knownOrNull returns m with its unknown values, as planned on create,
+// replaced by null. Used to keep the prior details when they cannot be read.
+func (m dagDetailsModel) knownOrNull() dagDetailsModel {
+	if m.Tags.IsUnknown() {
+		m.Tags = types.ListNull(types.StringType)
+	}
+	if m.Owners.IsUnknown() {
+		m.Owners = types.ListNull(types.StringType)
+	}
+	if m.ScheduleInterval.IsUnknown() {
+		m.ScheduleInterval = types.StringNull()
+	}
+	if m.TimetableDescription.IsUnknown() {
+		m.TimetableDescription = types.StringNull()
+	}
+	if m.NextDagrun.IsUnknown() {
+		m.NextDagrun = types.StringNull()
+	}
+	if m.MaxActiveRuns.IsUnknown() {
+		m.MaxActiveRuns = types.Int64Null()
+	}
+	if m.HasImportErrors.IsUnknown() {
+		m.HasImportErrors = types.BoolNull()
+	}
+	if m.LastParsedTime.IsUnknown() {
+		m.LastParsedTime = types.StringNull()
+	}
+	if m.DefaultView.IsUnknown() {
+		m.DefaultView = types.StringNull()
+	}
+	if m.Params.IsUnknown() {
+		m.Params = types.StringNull()
+	}
+	return m
+}
+
+// schedule returns the DAG schedule as a string: the cron expression for
+// cron schedules on API v1, the API v2 timetable summary, or the JSON of any
+// other API v1 schedule interval (e.g. a TimeDelta).
+func (d *dagDetails) schedule() string {
+	if d.TimetableSummary != "" {
+		return d.TimetableSummary
+	}
+
+	var interval map[string]interface{}
+	if json.Unmarshal(d.ScheduleInterval, &interval) != nil || interval == nil {
+		return ""
+	}
+	if v, ok := interval["value"].(string); ok && interval["__type"] == "CronExpression" {
+		return v
+	}
+	b, _ := json.Marshal(interval)
+	return string(b)
+}
+
+// toModel converts the details into their Terraform attribute values.
+func (d *dagDetails) toModel(ctx context.Context, diags *diag.Diagnostics) dagDetailsModel {
+	tags := make([]string, 0, len(d.Tags))
+	for _, t := range d.Tags {
+		tags = append(tags, t.Name)
+	}
+	tagsValue, ds := types.ListValueFrom(ctx, types.StringType, tags)
+	diags.Append(ds...)
+
+	owners := d.Owners
+	if owners == nil {
+		owners = []string{}
+	}
+	ownersValue, ds := types.ListValueFrom(ctx, types.StringType, owners)
+	diags.Append(ds...)
+
+	params := ""
+	if len(d.Params) > 0 {
+		b, err := json.Marshal(d.Params)
+		if err != nil {
+			diags.AddError("Failed to encode Airflow DAG params", err.Error())
+		}
+		params = string(b)
+	}
+
+	nextDagrun := d.NextDagrun
+	if nextDagrun == "" {
+		nextDagrun = d.NextDagrunLogicalDate
+	}
+
+	return dagDetailsModel{
+		Tags:                 tagsValue,
+		Owners:               ownersValue,
+		ScheduleInterval:     types.StringValue(d.schedule()),
+		TimetableDescription: types.StringValue(d.TimetableDescription),
+		NextDagrun:           types.StringValue(nextDagrun),
+		MaxActiveRuns:        types.Int64Value(d.MaxActiveRuns),
+		HasImportErrors:      types.BoolValue(d.HasImportErrors),
+		LastParsedTime:       types.StringValue(d.LastParsedTime),
+		DefaultView:          types.StringValue(d.DefaultView),
+		Params:               types.StringValue(params),
+	}
+}
+
+// reportImportErrors adds a diagnostic for every import error recorded against
+// the DAG's file -- an error when fail is set, a warning otherwise. The import
+// errors are only listed when the DAG reports it has some.
+func reportImportErrors(ctx context.Context, cfg client.ProviderConfig, details dagDetailsModel, dagID, fileloc string, fail bool, diags *diag.Diagnostics) {
+	if !details.HasImportErrors.ValueBool() || fileloc == "" {
+		return
+	}
+
+	importErrors, httpResp, err := dagImportErrors(ctx, cfg, func(ie airflow.ImportError) bool {
+		return importErrorMatches(ie.GetFilename(), fileloc)
+	})
+	if err != nil {
+		diags.AddWarning("Failed to list Airflow import errors", clientError("list", "import errors", httpResp, err))
+		return
+	}
+
+	for _, ie := range importErrors {
+		summary := "Airflow DAG has import errors"
+		detail := fmt.Sprintf("DAG %q failed to import from %s at %s:\n\n%s", dagID, ie.GetFilename(), ie.GetTimestamp(), ie.GetStackTrace())
+		if fail {
+			diags.AddError(summary, detail)
+		} else {
+			diags.AddWarning(summary, detail)
+		}
+	}
+}
+
+// dagNotFoundDetail explains that the DAG dagID does not exist in Airflow,
+// e.g. because its file was never parsed, and includes the import errors that
+// may be why: the ones for fileloc when it is known, otherwise the ones whose
+// file name or stack trace mention dagID.
+func dagNotFoundDetail(ctx context.Context, cfg client.ProviderConfig, dagID, fileloc string) string {
+	detail := fmt.Sprintf("DAG %q not found. Airflow only knows a DAG once the DAG processor has parsed its file without errors.", dagID)
+
+	importErrors, httpResp, err := dagImportErrors(ctx, cfg, func(ie airflow.ImportError) bool {
+		if fileloc != "" {
+			return importErrorMatches(ie.GetFilename(), fileloc)
+		}
+		return strings.Contains(ie.GetFilename(), dagID) || strings.Contains(ie.GetStackTrace(), dagID)
+	})
+	if err != nil {
+		return detail + "\n\nThe import errors could not be listed: " + clientError("list", "import errors", httpResp, err)
+	}
+	for _, ie := range importErrors {
+		detail += fmt.Sprintf("\n\nImport error in %s at %s:\n\n%s", ie.GetFilename(), ie.GetTimestamp(), ie.GetStackTrace())
+	}
+	return detail
+}
+
+// dagImportErrors lists the import errors that match.
+func dagImportErrors(ctx context.Context, cfg client.ProviderConfig, match func(airflow.ImportError) bool) ([]airflow.ImportError, *http.Response, error) {
+	return listPages[airflow.ImportError](ctx, cfg, "/importErrors", nil, "import_errors", match, 0)
+}
+
+// importErrorMatches reports whether an import error's file name refers to
+// fileloc. API v2 reports file names relative to the DAG bundle, so a relative
+// file name matches any fileloc ending in it.
+func importErrorMatches(filename, fileloc string) bool {
+	if filename == fileloc {
+		return true
+	}
+	return filename != "" && !strings.HasPrefix(filename, "/") && strings.HasSuffix(fileloc, "/"+filename)
+}
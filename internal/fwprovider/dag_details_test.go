@@ -0,0 +1,141 @@
+package fwprovider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/drfaust92/terraform-provider-airflow/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDagDetailsSchedule(t *testing.T) {
+	cases := []struct {
+		name, body, want string
+	}{
+		{"v1 cron", `{"schedule_interval":{"__type":"CronExpression","value":"0 0 * * *"}}`, "0 0 * * *"},
+		{"v1 timedelta", `{"schedule_interval":{"__type":"TimeDelta","days":1,"microseconds":0,"seconds":0}}`, `{"__type":"TimeDelta","days":1,"microseconds":0,"seconds":0}`},
+		{"v1 unscheduled", `{"schedule_interval":null}`, ""},
+		{"v2 timetable summary", `{"timetable_summary":"@daily"}`, "@daily"},
+		{"absent", `{}`, ""},
+	}
+	for _, c := range cases {
+		var d dagDetails
+		if err := json.Unmarshal([]byte(c.body), &d); err != nil {
+			t.Fatalf("%s: %s", c.name, err)
+		}
+		if got := d.schedule(); got != c.want {
+			t.Errorf("%s: schedule() = %q, want %q", c.name, got, c.want)
+		}
+	}
+}
+
+func TestDagDetailsToModel(t *testing.T) {
+	body := `{
+  "owners": ["airflow"],
+  "tags": [{"name": "example"}, {"name": "etl"}],
+  "next_dagrun_logical_date": "2026-01-02T00:00:00Z",
+  "max_active_runs": 16,
+  "params": {"env": {"value": "prod"}}
+}`
+	var d dagDetails
+	if err := json.Unmarshal([]byte(body), &d); err != nil {
+		t.Fatal(err)
+	}
+
+	var diags diag.Diagnostics
+	m := d.toModel(context.Background(), &diags)
+	if diags.HasError() {
+		t.Fatalf("toModel diagnostics: %+v", diags)
+	}
+	if got := len(m.Tags.Elements()); got != 2 {
+		t.Errorf("tags = %d elements, want 2", got)
+	}
+	if got := m.NextDagrun.ValueString(); got != "2026-01-02T00:00:00Z" {
+		t.Errorf("next_dagrun = %q, want the API v2 logical date", got)
+	}
+	if got := m.MaxActiveRuns.ValueInt64(); got != 16 {
+		t.Errorf("max_active_runs = %d, want 16", got)
+	}
+	if got := m.Params.ValueString(); got != `{"env":{"value":"prod"}}` {
+		t.Errorf("params = %q", got)
+	}
+}
+
+func TestDagDetailsKnownOrNull(t *testing.T) {
+	m := dagDetailsModel{
+		Tags:                 types.ListUnknown(types.StringType),
+		Owners:               types.ListUnknown(types.StringType),
+		ScheduleInterval:     types.StringValue("@daily"),
+		TimetableDescription: types.StringUnknown(),
+		NextDagrun:           types.StringUnknown(),
+		MaxActiveRuns:        types.Int64Unknown(),
+		HasImportErrors:      types.BoolUnknown(),
+		LastParsedTime:       types.StringUnknown(),
+		DefaultView:          types.StringUnknown(),
+		Params:               types.StringUnknown(),
+	}
+
+	got := m.knownOrNull()
+	if !got.ScheduleInterval.Equal(types.StringValue("@daily")) {
+		t.Errorf("schedule_interval = %s, want the prior value kept", got.ScheduleInterval)
+	}
+	if !got.Tags.IsNull() || !got.MaxActiveRuns.IsNull() || !got.HasImportErrors.IsNull() || !got.Params.IsNull() {
+		t.Errorf("unknown details not nulled: %+v", got)
+	}
+}
+
+func TestImportErrorMatches(t *testing.T) {
+	cases := []struct {
+		filename, fileloc string
+		want              bool
+	}{
+		{"/opt/airflow/dags/etl.py", "/opt/airflow/dags/etl.py", true},
+		{"etl.py", "/opt/airflow/dags/etl.py", true},
+		{"team/etl.py", "/opt/airflow/dags/team/etl.py", true},
+		{"l.py", "/opt/airflow/dags/etl.py", false},
+		{"/other/dags/etl.py", "/opt/airflow/dags/etl.py", false},
+		{"", "/opt/airflow/dags/etl.py", false},
+	}
+	for _, c := range cases {
+		if got := importErrorMatches(c.filename, c.fileloc); got != c.want {
+			t.Errorf("importErrorMatches(%q, %q) = %t, want %t", c.filename, c.fileloc, got, c.want)
+		}
+	}
+}
+
+func TestDagNotFoundDetail(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/api/v1/importErrors" {
+			http.NotFound(w, req)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"import_errors": [
+  {"filename": "/opt/airflow/dags/etl.py", "stack_trace": "SyntaxError: invalid syntax", "timestamp": "2026-01-01T00:00:00Z"},
+  {"filename": "/opt/airflow/dags/other.py", "stack_trace": "NameError: name 'x' is not defined", "timestamp": "2026-01-01T00:00:00Z"}
+], "total_entries": 2}`))
+	}))
+	defer srv.Close()
+
+	cfg, err := client.NewProviderConfig(srv.URL, "", "", "", false, "/api/v1", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+
+	// Never parsed, so only the DAG ID is known.
+	detail := dagNotFoundDetail(ctx, cfg, "etl", "")
+	if !strings.Contains(detail, "SyntaxError") || strings.Contains(detail, "NameError") {
+		t.Errorf("dagNotFoundDetail() by dag_id = %q, want only the import error of etl.py", detail)
+	}
+	detail = dagNotFoundDetail(ctx, cfg, "daily", "/opt/airflow/dags/other.py")
+	if !strings.Contains(detail, "NameError") || strings.Contains(detail, "SyntaxError") {
+		t.Errorf("dagNotFoundDetail() by fileloc = %q, want only the import error of other.py", detail)
+	}
+}
@@ -0,0 +1,153 @@
+package fwprovider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/drfaust92/terraform-provider-airflow/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// dagRunModel holds the DAG run attributes shared by the airflow_dag_run and
+// airflow_dag_runs data sources.
+type dagRunModel struct {
+	DagID             types.String `tfsdk:"dag_id"`
+	DagRunID          types.String `tfsdk:"dag_run_id"`
+	State             types.String `tfsdk:"state"`
+	RunType           types.String `tfsdk:"run_type"`
+	Conf              types.Map    `tfsdk:"conf"`
+	Note              types.String `tfsdk:"note"`
+	LogicalDate       types.String `tfsdk:"logical_date"`
+	DataIntervalStart types.String `tfsdk:"data_interval_start"`
+	DataIntervalEnd   types.String `tfsdk:"data_interval_end"`
+	QueuedAt          types.String `tfsdk:"queued_at"`
+	StartDate         types.String `tfsdk:"start_date"`
+	EndDate           types.String `tfsdk:"end_date"`
+}
+
+var dagRunAttrTypes = map[string]attr.Type{
+	"dag_id":              types.StringType,
+	"dag_run_id":          types.StringType,
+	"state":               types.StringType,
+	"run_type":            types.StringType,
+	"conf":                types.MapType{ElemType: types.StringType},
+	"note":                types.StringType,
+	"logical_date":        types.StringType,
+	"data_interval_start": types.StringType,
+	"data_interval_end":   types.StringType,
+	"queued_at":           types.StringType,
+	"start_date":          types.StringType,
+	"end_date":            types.StringType,
+}
+
+// dagRunSchemaAttributes returns the computed data source attributes of
+// dagRunModel.
+func dagRunSchemaAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"dag_id":              schema.StringAttribute{MarkdownDescription: "The DAG ID.", Computed: true},
+		"dag_run_id":          schema.StringAttribute{MarkdownDescription: "The DAG run ID.", Computed: true},
+		"state":               schema.StringAttribute{MarkdownDescription: "The DAG run state.", Computed: true},
+		"run_type":            schema.StringAttribute{MarkdownDescription: "How the run was created: `scheduled`, `manual`, `backfill`, `dataset_triggered` or `asset_triggered`.", Computed: true},
+		"conf":                schema.MapAttribute{MarkdownDescription: "The run configuration, with values rendered as strings.", Computed: true, ElementType: types.StringType},
+		"note":                schema.StringAttribute{MarkdownDescription: "The note attached to the run.", Computed: true},
+		"logical_date":        schema.StringAttribute{MarkdownDescription: "The logical (execution) date of the run.", Computed: true},
+		"data_interval_start": schema.StringAttribute{MarkdownDescription: "The start of the data interval.", Computed: true},
+		"data_interval_end":   schema.StringAttribute{MarkdownDescription: "The end of the data interval.", Computed: true},
+		"queued_at":           schema.StringAttribute{MarkdownDescription: "When the run was queued. Not reported by Airflow 2.", Computed: true},
+		"start_date":          schema.StringAttribute{MarkdownDescription: "When the run started.", Computed: true},
+		"end_date":            schema.StringAttribute{MarkdownDescription: "When the run ended.", Computed: true},
+	}
+}
+
+// dagRun is a DAG run as returned by API v1 and v2. Fields renamed in API v2
+// are listed under both names.
+type dagRun struct {
+	DagID             string                 `json:"dag_id"`
+	DagRunID          string                 `json:"dag_run_id"`
+	State             string                 `json:"state"`
+	RunType           string                 `json:"run_type"`
+	Conf              map[string]interface{} `json:"conf"`
+	Note              *string                `json:"note"`
+	ExecutionDate     *string                `json:"execution_date"`
+	LogicalDate       *string                `json:"logical_date"`
+	DataIntervalStart *string                `json:"data_interval_start"`
+	DataIntervalEnd   *string                `json:"data_interval_end"`
+	QueuedAt          *string                `json:"queued_at"`
+	StartDate         *string                `json:"start_date"`
+	EndDate           *string                `json:"end_date"`
+}
+
+func dagRunsPath(dagID string) string {
+	return fmt.Sprintf("/dags/%s/dagRuns", url.PathEscape(dagID))
+}
+
+func getDagRun(ctx context.Context, cfg client.ProviderConfig, dagID, dagRunID string) (*dagRun, *http.Response, error) {
+	var run dagRun
+	httpResp, err := cfg.Do(ctx, http.MethodGet, dagRunsPath(dagID)+"/"+url.PathEscape(dagRunID), nil, nil, &run)
+	if err != nil {
+		return nil, httpResp, err
+	}
+	return &run, httpResp, nil
+}
+
+// listDagRuns pages through the DAG runs of dagID ("~" for all DAGs) matching
+// query, stopping once limit runs (if positive) were collected. API v1 cannot
+// filter on run type, so runTypes is applied to each page instead.
+func listDagRuns(ctx context.Context, cfg client.ProviderConfig, dagID string, query url.Values, runTypes []string, limit int) ([]dagRun, *http.Response, error) {
+	var keep func(dagRun) bool
+	if cfg.IsV2() {
+		query = cloneValues(query)
+		for _, t := range runTypes {
+			query.Add("run_type", t)
+		}
+	} else if len(runTypes) > 0 {
+		keep = func(run dagRun) bool { return slices.Contains(runTypes, run.RunType) }
+	}
+	return listPages[dagRun](ctx, cfg, dagRunsPath(dagID), query, "dag_runs", keep, limit)
+}
+
+// dagRunDateField returns the API name of a DAG run field, filter or sort key
+// (e.g. logical_date_gte): API v1 calls the logical date execution_date.
+func dagRunDateField(cfg client.ProviderConfig, field string) string {
+	if cfg.IsV2() {
+		return field
+	}
+	return strings.Replace(field, "logical_date", "execution_date", 1)
+}
+
+// toModel converts the DAG run into its Terraform attribute values.
+func (r *dagRun) toModel(ctx context.Context, diags *diag.Diagnostics) dagRunModel {
+	logicalDate := r.LogicalDate
+	if logicalDate == nil {
+		logicalDate = r.ExecutionDate
+	}
+
+	confMap := make(map[string]string, len(r.Conf))
+	for k, v := range r.Conf {
+		confMap[k] = fmt.Sprintf("%v", v)
+	}
+	conf, d := types.MapValueFrom(ctx, types.StringType, confMap)
+	diags.Append(d...)
+
+	return dagRunModel{
+		DagID:             types.StringValue(r.DagID),
+		DagRunID:          types.StringValue(r.DagRunID),
+		State:             types.StringValue(r.State),
+		RunType:           types.StringValue(r.RunType),
+		Conf:              conf,
+		Note:              types.StringValue(derefString(r.Note)),
+		LogicalDate:       types.StringValue(derefString(logicalDate)),
+		DataIntervalStart: types.StringValue(derefString(r.DataIntervalStart)),
+		DataIntervalEnd:   types.StringValue(derefString(r.DataIntervalEnd)),
+		QueuedAt:          types.StringValue(derefString(r.QueuedAt)),
+		StartDate:         types.StringValue(derefString(r.StartDate)),
+		EndDate:           types.StringValue(derefString(r.EndDate)),
+	}
+}
@@ -0,0 +1,61 @@
+package fwprovider
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/drfaust92/terraform-provider-airflow/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+func TestDagRunToModel(t *testing.T) {
+	cases := []struct {
+		name, body, wantLogicalDate string
+	}{
+		{"v1 execution_date", `{"dag_id":"d","dag_run_id":"r","state":"failed","run_type":"scheduled","execution_date":"2026-01-01T00:00:00Z","conf":{"env":"prod"}}`, "2026-01-01T00:00:00Z"},
+		{"v2 logical_date", `{"dag_id":"d","dag_run_id":"r","state":"failed","run_type":"scheduled","logical_date":"2026-01-02T00:00:00Z","conf":{"env":"prod"}}`, "2026-01-02T00:00:00Z"},
+	}
+	for _, c := range cases {
+		var run dagRun
+		if err := json.Unmarshal([]byte(c.body), &run); err != nil {
+			t.Fatalf("%s: %s", c.name, err)
+		}
+
+		var diags diag.Diagnostics
+		m := run.toModel(context.Background(), &diags)
+		if diags.HasError() {
+			t.Fatalf("%s: toModel diagnostics: %+v", c.name, diags)
+		}
+		if got := m.LogicalDate.ValueString(); got != c.wantLogicalDate {
+			t.Errorf("%s: logical_date = %q, want %q", c.name, got, c.wantLogicalDate)
+		}
+		if got := m.Conf.Elements()["env"].String(); got != `"prod"` {
+			t.Errorf("%s: conf.env = %s, want \"prod\"", c.name, got)
+		}
+		if got := m.EndDate.ValueString(); got != "" {
+			t.Errorf("%s: end_date = %q, want empty", c.name, got)
+		}
+	}
+}
+
+func TestDagRunDateField(t *testing.T) {
+	v1 := client.ProviderConfig{BasePath: "http://localhost:8080/api/v1"}
+	v2 := client.ProviderConfig{BasePath: "http://localhost:8080/api/v2"}
+
+	cases := []struct {
+		cfg         client.ProviderConfig
+		field, want string
+	}{
+		{v1, "logical_date_gte", "execution_date_gte"},
+		{v1, "-logical_date", "-execution_date"},
+		{v1, "start_date_lte", "start_date_lte"},
+		{v2, "logical_date_gte", "logical_date_gte"},
+		{v2, "-logical_date", "-logical_date"},
+	}
+	for _, c := range cases {
+		if got := dagRunDateField(c.cfg, c.field); got != c.want {
+			t.Errorf("dagRunDateField(%s, %q) = %q, want %q", c.cfg.BasePath, c.field, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,144 @@
+package fwprovider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/drfaust92/terraform-provider-airflow/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource                     = &assetDataSource{}
+	_ datasource.DataSourceWithConfigure        = &assetDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &assetDataSource{}
+)
+
+func newAssetDataSource() datasource.DataSource {
+	return &assetDataSource{}
+}
+
+type assetDataSource struct {
+	config client.ProviderConfig
+}
+
+type assetDataSourceModel struct {
+	ID types.String `tfsdk:"id"`
+	assetModel
+}
+
+func (d *assetDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_asset"
+}
+
+func (d *assetDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attrs := assetSchemaAttributes()
+	attrs["id"] = schema.StringAttribute{MarkdownDescription: "The asset URI.", Computed: true}
+	attrs["uri"] = schema.StringAttribute{MarkdownDescription: "The URI of the asset to look up. Exactly one of `uri` or `name` must be set.", Optional: true, Computed: true}
+	attrs["name"] = schema.StringAttribute{MarkdownDescription: "The name of the asset to look up (Airflow 3 only). Exactly one of `uri` or `name` must be set.", Optional: true, Computed: true}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetches an existing Airflow asset (a dataset on Airflow 2), including the tasks producing it and the DAGs consuming it.",
+		Attributes:          attrs,
+	}
+}
+
+func (d *assetDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	cfg, ok := req.ProviderData.(client.ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.config = cfg
+}
+
+func (d *assetDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("uri"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
+func (d *assetDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data assetDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var (
+		a   *asset
+		key string
+	)
+	if !data.Name.IsNull() {
+		if !d.config.IsV2() {
+			resp.Diagnostics.AddAttributeError(path.Root("name"), "Unsupported Airflow version", "Looking up assets by name requires Airflow 3 (API v2); use uri instead.")
+			return
+		}
+		key = data.Name.ValueString()
+		found, httpResp, err := getAssetByName(ctx, d.config, key)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to read Airflow asset", clientError("read", key, httpResp, err))
+			return
+		}
+		a = found
+	} else {
+		key = data.URI.ValueString()
+		found, httpResp, err := getAssetByURI(ctx, d.config, key)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to read Airflow asset", clientError("read", key, httpResp, err))
+			return
+		}
+		a = found
+	}
+	if a == nil {
+		resp.Diagnostics.AddError("Airflow asset not found", fmt.Sprintf("No asset %q is known to Airflow.", key))
+		return
+	}
+
+	data.assetModel = a.toModel(ctx, d.config, &resp.Diagnostics)
+	data.ID = types.StringValue(a.URI)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// assetSchemaAttributes returns the computed data source attributes of
+// assetModel.
+func assetSchemaAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"asset_id":        schema.Int64Attribute{MarkdownDescription: "The asset ID.", Computed: true},
+		"uri":             schema.StringAttribute{MarkdownDescription: "The asset URI.", Computed: true},
+		"name":            schema.StringAttribute{MarkdownDescription: "The asset name. Airflow 3 only; empty on Airflow 2.", Computed: true},
+		"group":           schema.StringAttribute{MarkdownDescription: "The asset group. Airflow 3 only; empty on Airflow 2.", Computed: true},
+		"extra":           schema.StringAttribute{MarkdownDescription: "The JSON-encoded extra information of the asset.", Computed: true},
+		"created_at":      schema.StringAttribute{MarkdownDescription: "When the asset was created.", Computed: true},
+		"updated_at":      schema.StringAttribute{MarkdownDescription: "When the asset was last updated.", Computed: true},
+		"last_event_time": schema.StringAttribute{MarkdownDescription: "When the latest event of the asset was emitted, empty if it has none.", Computed: true},
+		"consuming_dags":  schema.ListAttribute{MarkdownDescription: "The IDs of the DAGs scheduled on the asset.", Computed: true, ElementType: types.StringType},
+		"producing_tasks": schema.ListNestedAttribute{
+			MarkdownDescription: "The tasks that produce the asset.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"dag_id":  schema.StringAttribute{MarkdownDescription: "The DAG ID of the task.", Computed: true},
+					"task_id": schema.StringAttribute{MarkdownDescription: "The task ID.", Computed: true},
+				},
+			},
+		},
+	}
+}
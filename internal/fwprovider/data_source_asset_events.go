@@ -0,0 +1,165 @@
+package fwprovider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/drfaust92/terraform-provider-airflow/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &assetEventsDataSource{}
+	_ datasource.DataSourceWithConfigure = &assetEventsDataSource{}
+)
+
+func newAssetEventsDataSource() datasource.DataSource {
+	return &assetEventsDataSource{}
+}
+
+type assetEventsDataSource struct {
+	config client.ProviderConfig
+}
+
+type assetEventsDataSourceModel struct {
+	ID      types.String `tfsdk:"id"`
+	URI     types.String `tfsdk:"uri"`
+	Limit   types.Int64  `tfsdk:"limit"`
+	AssetID types.Int64  `tfsdk:"asset_id"`
+	Events  types.List   `tfsdk:"events"`
+}
+
+var assetEventAttrTypes = map[string]attr.Type{
+	"id":               types.Int64Type,
+	"timestamp":        types.StringType,
+	"extra":            types.StringType,
+	"source_dag_id":    types.StringType,
+	"source_task_id":   types.StringType,
+	"source_run_id":    types.StringType,
+	"source_map_index": types.Int64Type,
+}
+
+func (d *assetEventsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_asset_events"
+}
+
+func (d *assetEventsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the most recent events of an Airflow asset (a dataset on Airflow 2), newest first.",
+		Attributes: map[string]schema.Attribute{
+			"id":  schema.StringAttribute{MarkdownDescription: "The asset URI.", Computed: true},
+			"uri": schema.StringAttribute{MarkdownDescription: "The asset URI.", Required: true},
+			"limit": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of events to return. Defaults to `25`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 100),
+				},
+			},
+			"asset_id": schema.Int64Attribute{MarkdownDescription: "The ID of the asset.", Computed: true},
+			"events": schema.ListNestedAttribute{
+				MarkdownDescription: "The events, newest first.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id":               schema.Int64Attribute{MarkdownDescription: "The event ID.", Computed: true},
+						"timestamp":        schema.StringAttribute{MarkdownDescription: "When the event was emitted.", Computed: true},
+						"extra":            schema.StringAttribute{MarkdownDescription: "The JSON-encoded extra information attached to the event.", Computed: true},
+						"source_dag_id":    schema.StringAttribute{MarkdownDescription: "The DAG that emitted the event, empty for events created through the API.", Computed: true},
+						"source_task_id":   schema.StringAttribute{MarkdownDescription: "The task that emitted the event.", Computed: true},
+						"source_run_id":    schema.StringAttribute{MarkdownDescription: "The DAG run that emitted the event.", Computed: true},
+						"source_map_index": schema.Int64Attribute{MarkdownDescription: "The map index of the task instance that emitted the event.", Computed: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *assetEventsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	cfg, ok := req.ProviderData.(client.ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.config = cfg
+}
+
+func (d *assetEventsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data assetEventsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	uri := data.URI.ValueString()
+	a, httpResp, err := getAssetByURI(ctx, d.config, uri)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read Airflow asset", clientError("read", uri, httpResp, err))
+		return
+	}
+	if a == nil {
+		resp.Diagnostics.AddError("Airflow asset not found", fmt.Sprintf("No asset with URI %q is known to Airflow.", uri))
+		return
+	}
+
+	limit := 25
+	if !data.Limit.IsNull() {
+		limit = int(data.Limit.ValueInt64())
+	}
+
+	events, httpResp, err := listAssetEvents(ctx, d.config, a, limit)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list Airflow asset events", clientError("list", uri, httpResp, err))
+		return
+	}
+
+	values := make([]attr.Value, 0, len(events))
+	for _, e := range events {
+		extra, err := encodeExtra(e.Extra)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to encode Airflow asset event extra", err.Error())
+			return
+		}
+
+		sourceMapIndex := types.Int64Null()
+		if e.SourceMapIndex != nil {
+			sourceMapIndex = types.Int64Value(*e.SourceMapIndex)
+		}
+
+		v, diags := types.ObjectValue(assetEventAttrTypes, map[string]attr.Value{
+			"id":               types.Int64Value(e.ID),
+			"timestamp":        types.StringValue(e.Timestamp),
+			"extra":            types.StringValue(extra),
+			"source_dag_id":    types.StringValue(derefString(e.SourceDagID)),
+			"source_task_id":   types.StringValue(derefString(e.SourceTaskID)),
+			"source_run_id":    types.StringValue(derefString(e.SourceRunID)),
+			"source_map_index": sourceMapIndex,
+		})
+		resp.Diagnostics.Append(diags...)
+		values = append(values, v)
+	}
+
+	eventsValue, diags := types.ListValue(types.ObjectType{AttrTypes: assetEventAttrTypes}, values)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(uri)
+	data.AssetID = types.Int64Value(a.ID)
+	data.Events = eventsValue
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
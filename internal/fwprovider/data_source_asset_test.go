@@ -0,0 +1,48 @@
+package fwprovider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAirflowAssetDataSource_basic(t *testing.T) {
+	if os.Getenv("SKIP_AIRFLOW_DAG_TESTS") == "true" {
+		t.Skip("Skipping Airflow DAG tests")
+	}
+
+	dataSourceName := "data.airflow_asset.test"
+	listName := "data.airflow_assets.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAirflowAssetDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "uri", testAccAssetURI),
+					resource.TestCheckResourceAttrSet(dataSourceName, "asset_id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "created_at"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "producing_tasks.#"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "consuming_dags.#"),
+					resource.TestCheckResourceAttr(listName, "assets.#", "1"),
+					resource.TestCheckResourceAttrPair(listName, "assets.0.asset_id", dataSourceName, "asset_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAirflowAssetDataSourceConfig() string {
+	return fmt.Sprintf(`
+data "airflow_asset" "test" {
+  uri = %[1]q
+}
+
+data "airflow_assets" "test" {
+  uri_pattern = %[1]q
+}
+`, testAccAssetURI)
+}
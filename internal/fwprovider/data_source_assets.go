@@ -0,0 +1,114 @@
+package fwprovider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/drfaust92/terraform-provider-airflow/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &assetsDataSource{}
+	_ datasource.DataSourceWithConfigure = &assetsDataSource{}
+)
+
+func newAssetsDataSource() datasource.DataSource {
+	return &assetsDataSource{}
+}
+
+type assetsDataSource struct {
+	config client.ProviderConfig
+}
+
+type assetsDataSourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	URIPattern types.String `tfsdk:"uri_pattern"`
+	DagID      types.String `tfsdk:"dag_id"`
+	Assets     types.List   `tfsdk:"assets"`
+}
+
+func (d *assetsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_assets"
+}
+
+func (d *assetsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists Airflow assets (datasets on Airflow 2), including the tasks producing them and the DAGs consuming them. On Airflow 2 the latest event of each asset is looked up with one extra request per asset.",
+		Attributes: map[string]schema.Attribute{
+			"id":          schema.StringAttribute{MarkdownDescription: "The filter identifier, built from `uri_pattern` and `dag_id`.", Computed: true},
+			"uri_pattern": schema.StringAttribute{MarkdownDescription: "Only list assets whose URI contains this pattern. SQL `LIKE` wildcards (`%`, `_`) are supported.", Optional: true},
+			"dag_id":      schema.StringAttribute{MarkdownDescription: "Only list assets produced or consumed by this DAG.", Optional: true},
+			"assets": schema.ListNestedAttribute{
+				MarkdownDescription: "The matching assets.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: assetSchemaAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (d *assetsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	cfg, ok := req.ProviderData.(client.ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.config = cfg
+}
+
+func (d *assetsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data assetsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	query := url.Values{}
+	if v := data.URIPattern.ValueString(); v != "" {
+		query.Set("uri_pattern", v)
+	}
+	if v := data.DagID.ValueString(); v != "" {
+		query.Set("dag_ids", v)
+	}
+
+	assets, httpResp, err := listAssets(ctx, d.config, query)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list Airflow assets", clientError("list", "assets", httpResp, err))
+		return
+	}
+
+	values := make([]attr.Value, 0, len(assets))
+	for i := range assets {
+		m := assets[i].toModel(ctx, d.config, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		v, diags := types.ObjectValueFrom(ctx, assetAttrTypes, m)
+		resp.Diagnostics.Append(diags...)
+		values = append(values, v)
+	}
+
+	assetsValue, diags := types.ListValue(types.ObjectType{AttrTypes: assetAttrTypes}, values)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s:%s", data.URIPattern.ValueString(), data.DagID.ValueString()))
+	data.Assets = assetsValue
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -33,6 +33,7 @@ type connectionDataSourceModel struct {
 	Schema       types.String `tfsdk:"schema"`
 	Port         types.Int64  `tfsdk:"port"`
 	Extra        types.String `tfsdk:"extra"`
+	URI          types.String `tfsdk:"uri"`
 	TeamName     types.String `tfsdk:"team_name"`
 }
 
@@ -53,6 +54,7 @@ func (d *connectionDataSource) Schema(_ context.Context, _ datasource.SchemaRequ
 			"schema":        schema.StringAttribute{MarkdownDescription: "The connection schema.", Computed: true},
 			"port":          schema.Int64Attribute{MarkdownDescription: "The connection port.", Computed: true},
 			"extra":         schema.StringAttribute{MarkdownDescription: "The connection extra field. Secret-like values may be masked by Airflow.", Computed: true},
+			"uri":           schema.StringAttribute{MarkdownDescription: "The connection as an Airflow connection URI, with the password replaced by `***` when Airflow reports one. Sensitive, as it includes the extra.", Computed: true, Sensitive: true},
 			"team_name":     schema.StringAttribute{MarkdownDescription: "Team name (Airflow 3 multi-team deployments).", Computed: true},
 		},
 	}
@@ -96,6 +98,7 @@ func (d *connectionDataSource) Read(ctx context.Context, req datasource.ReadRequ
 	data.Schema = types.StringValue(conn.GetSchema())
 	data.Port = types.Int64Value(int64(conn.GetPort()))
 	data.Extra = types.StringValue(conn.GetExtra())
+	data.URI = types.StringValue(connectionURIString(conn, conn.GetPassword() != ""))
 	data.TeamName = types.StringValue(conn.GetTeamName())
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
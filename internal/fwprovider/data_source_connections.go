@@ -0,0 +1,151 @@
+package fwprovider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/drfaust92/terraform-provider-airflow/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &connectionsDataSource{}
+	_ datasource.DataSourceWithConfigure = &connectionsDataSource{}
+)
+
+func newConnectionsDataSource() datasource.DataSource {
+	return &connectionsDataSource{}
+}
+
+type connectionsDataSource struct {
+	config client.ProviderConfig
+}
+
+type connectionsDataSourceModel struct {
+	ID                  types.String `tfsdk:"id"`
+	ConnectionIDPattern types.String `tfsdk:"connection_id_pattern"`
+	ConnType            types.String `tfsdk:"conn_type"`
+	TeamName            types.String `tfsdk:"team_name"`
+	ConnectionIDs       types.List   `tfsdk:"connection_ids"`
+	Connections         types.List   `tfsdk:"connections"`
+}
+
+var connectionItemAttrTypes = map[string]attr.Type{
+	"connection_id": types.StringType,
+	"conn_type":     types.StringType,
+	"description":   types.StringType,
+	"host":          types.StringType,
+	"login":         types.StringType,
+	"schema":        types.StringType,
+	"port":          types.Int64Type,
+	"team_name":     types.StringType,
+}
+
+func (d *connectionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_connections"
+}
+
+func (d *connectionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists Airflow connections, e.g. to check that every connection a DAG needs exists. Passwords and extras are not exposed.",
+		Attributes: map[string]schema.Attribute{
+			"id":                    schema.StringAttribute{MarkdownDescription: "The filter identifier, built from `connection_id_pattern`, `conn_type` and `team_name`.", Computed: true},
+			"connection_id_pattern": schema.StringAttribute{MarkdownDescription: "Only list connections whose ID contains this pattern. SQL `LIKE` wildcards (`%`, `_`) are supported.", Optional: true},
+			"conn_type":             schema.StringAttribute{MarkdownDescription: "Only list connections of this type.", Optional: true},
+			"team_name":             schema.StringAttribute{MarkdownDescription: "Only list connections of this team (Airflow 3 multi-team deployments).", Optional: true},
+			"connection_ids":        schema.ListAttribute{MarkdownDescription: "The IDs of the matching connections, sorted.", Computed: true, ElementType: types.StringType},
+			"connections": schema.ListNestedAttribute{
+				MarkdownDescription: "The matching connections, sorted by ID.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"connection_id": schema.StringAttribute{MarkdownDescription: "The connection ID.", Computed: true},
+						"conn_type":     schema.StringAttribute{MarkdownDescription: "The connection type.", Computed: true},
+						"description":   schema.StringAttribute{MarkdownDescription: "The connection description.", Computed: true},
+						"host":          schema.StringAttribute{MarkdownDescription: "The connection host.", Computed: true},
+						"login":         schema.StringAttribute{MarkdownDescription: "The connection login.", Computed: true},
+						"schema":        schema.StringAttribute{MarkdownDescription: "The connection schema.", Computed: true},
+						"port":          schema.Int64Attribute{MarkdownDescription: "The connection port, `0` if unset.", Computed: true},
+						"team_name":     schema.StringAttribute{MarkdownDescription: "Team name (Airflow 3 multi-team deployments).", Computed: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *connectionsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	cfg, ok := req.ProviderData.(client.ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.config = cfg
+}
+
+func (d *connectionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data connectionsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pattern := data.ConnectionIDPattern.ValueString()
+	connType := data.ConnType.ValueString()
+	teamName := data.TeamName.ValueString()
+	id := fmt.Sprintf("%s:%s:%s", pattern, connType, teamName)
+
+	conns, httpResp, err := listConnections(ctx, d.config, pattern)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list Airflow connections", clientError("list", id, httpResp, err))
+		return
+	}
+
+	ids := []attr.Value{}
+	values := []attr.Value{}
+	for _, c := range conns {
+		// The API cannot filter on these.
+		if connType != "" && c.ConnType != connType || teamName != "" && derefString(c.TeamName) != teamName {
+			continue
+		}
+		port := int64(0)
+		if c.Port != nil {
+			port = *c.Port
+		}
+		v, diags := types.ObjectValue(connectionItemAttrTypes, map[string]attr.Value{
+			"connection_id": types.StringValue(c.ConnectionID),
+			"conn_type":     types.StringValue(c.ConnType),
+			"description":   types.StringValue(derefString(c.Description)),
+			"host":          types.StringValue(derefString(c.Host)),
+			"login":         types.StringValue(derefString(c.Login)),
+			"schema":        types.StringValue(derefString(c.Schema)),
+			"port":          types.Int64Value(port),
+			"team_name":     types.StringValue(derefString(c.TeamName)),
+		})
+		resp.Diagnostics.Append(diags...)
+		ids = append(ids, types.StringValue(c.ConnectionID))
+		values = append(values, v)
+	}
+	connectionIDs, diags := types.ListValue(types.StringType, ids)
+	resp.Diagnostics.Append(diags...)
+	connections, diags := types.ListValue(types.ObjectType{AttrTypes: connectionItemAttrTypes}, values)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(id)
+	data.ConnectionIDs = connectionIDs
+	data.Connections = connections
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,163 @@
+package fwprovider
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &connectionsFileDataSource{}
+
+func newConnectionsFileDataSource() datasource.DataSource {
+	return &connectionsFileDataSource{}
+}
+
+// connectionsFileDataSource parses connection export files locally; it never
+// calls the Airflow API.
+type connectionsFileDataSource struct{}
+
+type connectionsFileDataSourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Path        types.String `tfsdk:"path"`
+	Content     types.String `tfsdk:"content"`
+	Format      types.String `tfsdk:"format"`
+	Connections types.Map    `tfsdk:"connections"`
+}
+
+var exportedConnectionAttrTypes = map[string]attr.Type{
+	"conn_type":   types.StringType,
+	"description": types.StringType,
+	"host":        types.StringType,
+	"login":       types.StringType,
+	"password":    types.StringType,
+	"schema":      types.StringType,
+	"port":        types.Int64Type,
+	"extra":       types.StringType,
+}
+
+func (d *connectionsFileDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_connections_file"
+}
+
+func (d *connectionsFileDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Parses a file written by `airflow connections export` (JSON, YAML or env, with URI or JSON serialization), e.g. to migrate the connections of another environment with `for_each` over `airflow_connection`. The file is read locally and Airflow is not called. Connections may also be given as connection URIs, as accepted by Airflow's local filesystem secrets backend.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{MarkdownDescription: "The SHA-1 checksum of the parsed content.", Computed: true},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "The path of the file to parse. Conflicts with `content`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("content")),
+				},
+			},
+			"content": schema.StringAttribute{
+				MarkdownDescription: "The content to parse, e.g. from a secret store. Conflicts with `path`.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"format": schema.StringAttribute{
+				MarkdownDescription: "The format: `json`, `yaml` or `env`. Defaults to the format of the `path` extension (`.json`, `.yaml`/`.yml` or `.env`), or else to the format the content looks like.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(connectionsFileJSON, connectionsFileYAML, connectionsFileEnv),
+				},
+			},
+			"connections": schema.MapNestedAttribute{
+				MarkdownDescription: "The connections by connection ID. Fields that are empty in the file are null.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"conn_type":   schema.StringAttribute{MarkdownDescription: "The connection type.", Computed: true},
+						"description": schema.StringAttribute{MarkdownDescription: "The connection description.", Computed: true},
+						"host":        schema.StringAttribute{MarkdownDescription: "The connection host.", Computed: true},
+						"login":       schema.StringAttribute{MarkdownDescription: "The connection login.", Computed: true},
+						"password":    schema.StringAttribute{MarkdownDescription: "The connection password.", Computed: true, Sensitive: true},
+						"schema":      schema.StringAttribute{MarkdownDescription: "The connection schema.", Computed: true},
+						"port":        schema.Int64Attribute{MarkdownDescription: "The connection port.", Computed: true},
+						"extra":       schema.StringAttribute{MarkdownDescription: "The connection extra, as JSON when the file holds an object.", Computed: true, Sensitive: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *connectionsFileDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data connectionsFileDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	content := []byte(data.Content.ValueString())
+	format := data.Format.ValueString()
+	if p := data.Path.ValueString(); p != "" {
+		b, err := os.ReadFile(p)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("path"), "Failed to read Airflow connections file", err.Error())
+			return
+		}
+		content = b
+		if format == "" {
+			format = connectionsFileFormat(p)
+		}
+	}
+	if format == "" {
+		format = sniffConnectionsFileFormat(content)
+	}
+
+	conns, err := parseConnectionsFile(content, format)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to parse Airflow connections file", err.Error())
+		return
+	}
+
+	optional := func(s string) types.String {
+		if s == "" {
+			return types.StringNull()
+		}
+		return types.StringValue(s)
+	}
+	values := make(map[string]attr.Value, len(conns))
+	for id, c := range conns {
+		port := types.Int64Null()
+		if c.Port != 0 {
+			port = types.Int64Value(c.Port)
+		}
+		v, diags := types.ObjectValue(exportedConnectionAttrTypes, map[string]attr.Value{
+			"conn_type":   optional(c.ConnType),
+			"description": optional(c.Description),
+			"host":        optional(c.Host),
+			"login":       optional(c.Login),
+			"password":    optional(c.Password),
+			"schema":      optional(c.Schema),
+			"port":        port,
+			"extra":       optional(c.Extra),
+		})
+		resp.Diagnostics.Append(diags...)
+		values[id] = v
+	}
+	connections, diags := types.MapValue(types.ObjectType{AttrTypes: exportedConnectionAttrTypes}, values)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sum := sha1.Sum(content)
+	data.ID = types.StringValue(hex.EncodeToString(sum[:]))
+	data.Format = types.StringValue(format)
+	data.Connections = connections
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,51 @@
+package fwprovider
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAirflowConnectionsFileDataSource_basic(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "connections.yaml")
+	if err := os.WriteFile(path, []byte(`
+pg:
+  conn_type: postgres
+  host: db
+  password: secret
+  port: 5432
+  extra: '{"sslmode": "require"}'
+`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "airflow_connections_file" "test" {
+  path = "` + filepath.ToSlash(path) + `"
+}
+
+data "airflow_connections_file" "env" {
+  content = "api=http://https://api.example.com\n"
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.airflow_connections_file.test", "format", "yaml"),
+					resource.TestCheckResourceAttr("data.airflow_connections_file.test", "connections.%", "1"),
+					resource.TestCheckResourceAttr("data.airflow_connections_file.test", "connections.pg.conn_type", "postgres"),
+					resource.TestCheckResourceAttr("data.airflow_connections_file.test", "connections.pg.password", "secret"),
+					resource.TestCheckResourceAttr("data.airflow_connections_file.test", "connections.pg.port", "5432"),
+					resource.TestCheckNoResourceAttr("data.airflow_connections_file.test", "connections.pg.login"),
+					resource.TestCheckResourceAttr("data.airflow_connections_file.env", "format", "env"),
+					resource.TestCheckResourceAttr("data.airflow_connections_file.env", "connections.api.host", "https://api.example.com"),
+				),
+			},
+		},
+	})
+}
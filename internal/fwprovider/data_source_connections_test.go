@@ -0,0 +1,58 @@
+package fwprovider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAirflowConnectionsDataSource_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	dataSourceName := "data.airflow_connections.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckAirflowConnectionCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "airflow_connection" "http" {
+  connection_id = "%[1]s_http"
+  conn_type     = "http"
+  host          = "example.com"
+  port          = 443
+}
+
+resource "airflow_connection" "fs" {
+  connection_id = "%[1]s_fs"
+  conn_type     = "fs"
+}
+
+data "airflow_connections" "test" {
+  connection_id_pattern = %[1]q
+  conn_type             = "http"
+
+  depends_on = [airflow_connection.http, airflow_connection.fs]
+}
+
+data "airflow_connections" "all" {
+  connection_id_pattern = %[1]q
+
+  depends_on = [airflow_connection.http, airflow_connection.fs]
+}
+`, rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "connection_ids.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "connection_ids.0", rName+"_http"),
+					resource.TestCheckResourceAttr(dataSourceName, "connections.0.host", "example.com"),
+					resource.TestCheckResourceAttr(dataSourceName, "connections.0.port", "443"),
+					resource.TestCheckResourceAttr("data.airflow_connections.all", "connection_ids.#", "2"),
+					resource.TestCheckResourceAttr("data.airflow_connections.all", "connection_ids.0", rName+"_fs"),
+				),
+			},
+		},
+	})
+}
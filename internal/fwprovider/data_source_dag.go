@@ -3,6 +3,7 @@ package fwprovider
 import (
 	"context"
 	"fmt"
+	"net/http"
 
 	"github.com/drfaust92/terraform-provider-airflow/internal/client"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -24,15 +25,17 @@ type dagDataSource struct {
 }
 
 type dagDataSourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	DagID       types.String `tfsdk:"dag_id"`
-	Description types.String `tfsdk:"description"`
-	FileToken   types.String `tfsdk:"file_token"`
-	Fileloc     types.String `tfsdk:"fileloc"`
-	IsActive    types.Bool   `tfsdk:"is_active"`
-	IsPaused    types.Bool   `tfsdk:"is_paused"`
-	IsSubdag    types.Bool   `tfsdk:"is_subdag"`
-	RootDagID   types.String `tfsdk:"root_dag_id"`
+	ID                 types.String `tfsdk:"id"`
+	DagID              types.String `tfsdk:"dag_id"`
+	FailOnImportErrors types.Bool   `tfsdk:"fail_on_import_errors"`
+	Description        types.String `tfsdk:"description"`
+	FileToken          types.String `tfsdk:"file_token"`
+	Fileloc            types.String `tfsdk:"fileloc"`
+	IsActive           types.Bool   `tfsdk:"is_active"`
+	IsPaused           types.Bool   `tfsdk:"is_paused"`
+	IsSubdag           types.Bool   `tfsdk:"is_subdag"`
+	RootDagID          types.String `tfsdk:"root_dag_id"`
+	dagDetailsModel
 }
 
 func (d *dagDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -43,15 +46,26 @@ func (d *dagDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, re
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Fetches an existing Airflow DAG.",
 		Attributes: map[string]schema.Attribute{
-			"id":          schema.StringAttribute{MarkdownDescription: "The DAG ID.", Computed: true},
-			"dag_id":      schema.StringAttribute{MarkdownDescription: "The DAG ID.", Required: true},
-			"description": schema.StringAttribute{MarkdownDescription: "The DAG description.", Computed: true},
-			"file_token":  schema.StringAttribute{MarkdownDescription: "The DAG file token.", Computed: true},
-			"fileloc":     schema.StringAttribute{MarkdownDescription: "The DAG file location.", Computed: true},
-			"is_active":   schema.BoolAttribute{MarkdownDescription: "Whether the DAG is active.", Computed: true},
-			"is_paused":   schema.BoolAttribute{MarkdownDescription: "Whether the DAG is paused.", Computed: true},
-			"is_subdag":   schema.BoolAttribute{MarkdownDescription: "Whether the DAG is a subdag.", Computed: true},
-			"root_dag_id": schema.StringAttribute{MarkdownDescription: "The root DAG ID (for subdags).", Computed: true},
+			"id":                    schema.StringAttribute{MarkdownDescription: "The DAG ID.", Computed: true},
+			"dag_id":                schema.StringAttribute{MarkdownDescription: "The DAG ID.", Required: true},
+			"fail_on_import_errors": schema.BoolAttribute{MarkdownDescription: "Whether import errors for the DAG's file are reported as errors rather than warnings.", Optional: true},
+			"description":           schema.StringAttribute{MarkdownDescription: "The DAG description.", Computed: true},
+			"file_token":            schema.StringAttribute{MarkdownDescription: "The DAG file token.", Computed: true},
+			"fileloc":               schema.StringAttribute{MarkdownDescription: "The DAG file location.", Computed: true},
+			"is_active":             schema.BoolAttribute{MarkdownDescription: "Whether the DAG is active.", Computed: true},
+			"is_paused":             schema.BoolAttribute{MarkdownDescription: "Whether the DAG is paused.", Computed: true},
+			"is_subdag":             schema.BoolAttribute{MarkdownDescription: "Whether the DAG is a subdag.", Computed: true},
+			"root_dag_id":           schema.StringAttribute{MarkdownDescription: "The root DAG ID (for subdags).", Computed: true},
+			"tags":                  schema.ListAttribute{MarkdownDescription: "The names of the DAG's tags.", Computed: true, ElementType: types.StringType},
+			"owners":                schema.ListAttribute{MarkdownDescription: "The owners of the DAG.", Computed: true, ElementType: types.StringType},
+			"schedule_interval":     schema.StringAttribute{MarkdownDescription: "The DAG schedule: the cron expression, the timetable summary on Airflow 3, or the JSON-encoded schedule interval for non-cron schedules on Airflow 2.", Computed: true},
+			"timetable_description": schema.StringAttribute{MarkdownDescription: "A human-readable description of the DAG's timetable.", Computed: true},
+			"next_dagrun":           schema.StringAttribute{MarkdownDescription: "The logical date of the next DAG run, in RFC 3339 format.", Computed: true},
+			"max_active_runs":       schema.Int64Attribute{MarkdownDescription: "The maximum number of active DAG runs.", Computed: true},
+			"has_import_errors":     schema.BoolAttribute{MarkdownDescription: "Whether the DAG file has import errors.", Computed: true},
+			"last_parsed_time":      schema.StringAttribute{MarkdownDescription: "The last time the DAG file was parsed, in RFC 3339 format.", Computed: true},
+			"default_view":          schema.StringAttribute{MarkdownDescription: "The default UI view of the DAG. Not reported by Airflow 3.", Computed: true},
+			"params":                schema.StringAttribute{MarkdownDescription: "The JSON-encoded DAG params.", Computed: true},
 		},
 	}
 }
@@ -80,6 +94,10 @@ func (d *dagDataSource) Read(ctx context.Context, req datasource.ReadRequest, re
 
 	id := data.DagID.ValueString()
 	dag, httpResp, err := d.config.ApiClient.DAGApi.GetDag(d.config.AuthContext, id).Execute()
+	if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+		resp.Diagnostics.AddError("Failed to read Airflow DAG", dagNotFoundDetail(ctx, d.config, id, ""))
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to read Airflow DAG", clientError("read", id, httpResp, err))
 		return
@@ -95,5 +113,20 @@ func (d *dagDataSource) Read(ctx context.Context, req datasource.ReadRequest, re
 	data.IsSubdag = types.BoolValue(dag.GetIsSubdag())
 	data.RootDagID = types.StringValue(derefString(dag.RootDagId.Get()))
 
+	// As in the resource, the details are informational: without them (e.g.
+	// without the permission to read them) they are left null.
+	details, httpResp, err := getDagDetails(ctx, d.config, id)
+	if err != nil {
+		resp.Diagnostics.AddWarning("Failed to read Airflow DAG details", clientError("read", id, httpResp, err))
+		data.dagDetailsModel = data.dagDetailsModel.knownOrNull()
+	} else {
+		data.dagDetailsModel = details.toModel(ctx, &resp.Diagnostics)
+	}
+
+	reportImportErrors(ctx, d.config, data.dagDetailsModel, id, data.Fileloc.ValueString(), data.FailOnImportErrors.ValueBool(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -0,0 +1,83 @@
+package fwprovider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/drfaust92/terraform-provider-airflow/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &dagRunDataSource{}
+	_ datasource.DataSourceWithConfigure = &dagRunDataSource{}
+)
+
+func newDagRunDataSource() datasource.DataSource {
+	return &dagRunDataSource{}
+}
+
+type dagRunDataSource struct {
+	config client.ProviderConfig
+}
+
+type dagRunDataSourceModel struct {
+	ID types.String `tfsdk:"id"`
+	dagRunModel
+}
+
+func (d *dagRunDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dag_run"
+}
+
+func (d *dagRunDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attrs := dagRunSchemaAttributes()
+	attrs["id"] = schema.StringAttribute{MarkdownDescription: "The DAG run identifier in the form `dag_id:dag_run_id`.", Computed: true}
+	attrs["dag_id"] = schema.StringAttribute{MarkdownDescription: "The DAG ID.", Required: true}
+	attrs["dag_run_id"] = schema.StringAttribute{MarkdownDescription: "The DAG run ID.", Required: true}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetches an existing Airflow DAG run, including runs not created by Terraform.",
+		Attributes:          attrs,
+	}
+}
+
+func (d *dagRunDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	cfg, ok := req.ProviderData.(client.ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.config = cfg
+}
+
+func (d *dagRunDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data dagRunDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := fmt.Sprintf("%s:%s", data.DagID.ValueString(), data.DagRunID.ValueString())
+	run, httpResp, err := getDagRun(ctx, d.config, data.DagID.ValueString(), data.DagRunID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read Airflow DAG run", clientError("read", id, httpResp, err))
+		return
+	}
+
+	data.dagRunModel = run.toModel(ctx, &resp.Diagnostics)
+	data.ID = types.StringValue(id)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,70 @@
+package fwprovider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAirflowDagRunDataSource_basic(t *testing.T) {
+	if os.Getenv("SKIP_AIRFLOW_DAG_TESTS") == "true" {
+		t.Skip("Skipping Airflow DAG tests")
+	}
+
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	dataSourceName := "data.airflow_dag_run.test"
+	listName := "data.airflow_dag_runs.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckAirflowDagRunCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAirflowDagRunDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", fmt.Sprintf("%s:%s", dagId, rName)),
+					resource.TestCheckResourceAttr(dataSourceName, "run_type", "manual"),
+					resource.TestCheckResourceAttr(dataSourceName, "conf.env", "test"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "state"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "logical_date"),
+					resource.TestCheckResourceAttr(listName, "dag_runs.#", "1"),
+					resource.TestCheckResourceAttr(listName, "dag_runs.0.dag_id", dagId),
+					resource.TestCheckResourceAttr(listName, "dag_runs.0.run_type", "manual"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAirflowDagRunDataSourceConfig(dagRunId string) string {
+	return fmt.Sprintf(`
+resource "airflow_dag" "test" {
+  dag_id    = %[1]q
+  is_paused = false
+}
+
+resource "airflow_dag_run" "test" {
+  dag_id     = airflow_dag.test.dag_id
+  dag_run_id = %[2]q
+
+  conf = {
+    env = "test"
+  }
+}
+
+data "airflow_dag_run" "test" {
+  dag_id     = airflow_dag_run.test.dag_id
+  dag_run_id = airflow_dag_run.test.dag_run_id
+}
+
+data "airflow_dag_runs" "test" {
+  dag_id   = airflow_dag_run.test.dag_id
+  run_type = ["manual"]
+  order_by = "-start_date"
+  limit    = 1
+}
+`, dagId, dagRunId)
+}
@@ -0,0 +1,173 @@
+package fwprovider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/drfaust92/terraform-provider-airflow/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &dagRunsDataSource{}
+	_ datasource.DataSourceWithConfigure = &dagRunsDataSource{}
+)
+
+func newDagRunsDataSource() datasource.DataSource {
+	return &dagRunsDataSource{}
+}
+
+type dagRunsDataSource struct {
+	config client.ProviderConfig
+}
+
+type dagRunsDataSourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	DagID          types.String `tfsdk:"dag_id"`
+	State          types.List   `tfsdk:"state"`
+	RunType        types.List   `tfsdk:"run_type"`
+	LogicalDateGte types.String `tfsdk:"logical_date_gte"`
+	LogicalDateLte types.String `tfsdk:"logical_date_lte"`
+	StartDateGte   types.String `tfsdk:"start_date_gte"`
+	StartDateLte   types.String `tfsdk:"start_date_lte"`
+	EndDateGte     types.String `tfsdk:"end_date_gte"`
+	EndDateLte     types.String `tfsdk:"end_date_lte"`
+	OrderBy        types.String `tfsdk:"order_by"`
+	Limit          types.Int64  `tfsdk:"limit"`
+	DagRuns        types.List   `tfsdk:"dag_runs"`
+}
+
+func (d *dagRunsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dag_runs"
+}
+
+func (d *dagRunsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	dateFilter := func(description string) schema.StringAttribute {
+		return schema.StringAttribute{
+			MarkdownDescription: description,
+			Optional:            true,
+			Validators: []validator.String{
+				rfc3339Validator{},
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists Airflow DAG runs, e.g. to check the outcome of the latest scheduled run of a DAG.",
+		Attributes: map[string]schema.Attribute{
+			"id":               schema.StringAttribute{MarkdownDescription: "The DAG ID filter.", Computed: true},
+			"dag_id":           schema.StringAttribute{MarkdownDescription: "Only list runs of this DAG. Defaults to all DAGs (`~`).", Optional: true},
+			"state":            schema.ListAttribute{MarkdownDescription: "Only list runs in one of these states.", Optional: true, ElementType: types.StringType},
+			"run_type":         schema.ListAttribute{MarkdownDescription: "Only list runs of one of these types, e.g. `scheduled` or `manual`.", Optional: true, ElementType: types.StringType},
+			"logical_date_gte": dateFilter("Only list runs with a logical date at or after this RFC 3339 timestamp."),
+			"logical_date_lte": dateFilter("Only list runs with a logical date at or before this RFC 3339 timestamp."),
+			"start_date_gte":   dateFilter("Only list runs that started at or after this RFC 3339 timestamp."),
+			"start_date_lte":   dateFilter("Only list runs that started at or before this RFC 3339 timestamp."),
+			"end_date_gte":     dateFilter("Only list runs that ended at or after this RFC 3339 timestamp."),
+			"end_date_lte":     dateFilter("Only list runs that ended at or before this RFC 3339 timestamp."),
+			"order_by":         schema.StringAttribute{MarkdownDescription: "The field to sort by, prefixed with `-` for descending order. Defaults to `-logical_date` (newest first).", Optional: true},
+			"limit": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of runs to return. All matching runs are returned when unset.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"dag_runs": schema.ListNestedAttribute{
+				MarkdownDescription: "The matching DAG runs.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: dagRunSchemaAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (d *dagRunsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	cfg, ok := req.ProviderData.(client.ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.config = cfg
+}
+
+func (d *dagRunsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data dagRunsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dagID := data.DagID.ValueString()
+	if dagID == "" {
+		dagID = "~"
+	}
+
+	query := url.Values{}
+	if !data.State.IsNull() && !data.State.IsUnknown() {
+		var states []string
+		resp.Diagnostics.Append(data.State.ElementsAs(ctx, &states, false)...)
+		query["state"] = states
+	}
+	var runTypes []string
+	if !data.RunType.IsNull() && !data.RunType.IsUnknown() {
+		resp.Diagnostics.Append(data.RunType.ElementsAs(ctx, &runTypes, false)...)
+	}
+	for param, v := range map[string]types.String{
+		"logical_date_gte": data.LogicalDateGte,
+		"logical_date_lte": data.LogicalDateLte,
+		"start_date_gte":   data.StartDateGte,
+		"start_date_lte":   data.StartDateLte,
+		"end_date_gte":     data.EndDateGte,
+		"end_date_lte":     data.EndDateLte,
+	} {
+		if s := v.ValueString(); s != "" {
+			query.Set(dagRunDateField(d.config, param), s)
+		}
+	}
+	orderBy := data.OrderBy.ValueString()
+	if orderBy == "" {
+		orderBy = "-logical_date"
+	}
+	query.Set("order_by", dagRunDateField(d.config, orderBy))
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	runs, httpResp, err := listDagRuns(ctx, d.config, dagID, query, runTypes, int(data.Limit.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list Airflow DAG runs", clientError("list", dagID, httpResp, err))
+		return
+	}
+
+	values := make([]attr.Value, 0, len(runs))
+	for i := range runs {
+		v, diags := types.ObjectValueFrom(ctx, dagRunAttrTypes, runs[i].toModel(ctx, &resp.Diagnostics))
+		resp.Diagnostics.Append(diags...)
+		values = append(values, v)
+	}
+	dagRuns, diags := types.ListValue(types.ObjectType{AttrTypes: dagRunAttrTypes}, values)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(dagID)
+	data.DagRuns = dagRuns
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,90 @@
+package fwprovider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/drfaust92/terraform-provider-airflow/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &taskInstanceDataSource{}
+	_ datasource.DataSourceWithConfigure = &taskInstanceDataSource{}
+)
+
+func newTaskInstanceDataSource() datasource.DataSource {
+	return &taskInstanceDataSource{}
+}
+
+type taskInstanceDataSource struct {
+	config client.ProviderConfig
+}
+
+type taskInstanceDataSourceModel struct {
+	ID types.String `tfsdk:"id"`
+	taskInstanceModel
+}
+
+func (d *taskInstanceDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_task_instance"
+}
+
+func (d *taskInstanceDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attrs := taskInstanceSchemaAttributes()
+	attrs["id"] = schema.StringAttribute{MarkdownDescription: "The task instance identifier in the form `dag_id:dag_run_id:task_id:map_index`.", Computed: true}
+	attrs["dag_id"] = schema.StringAttribute{MarkdownDescription: "The DAG ID.", Required: true}
+	attrs["dag_run_id"] = schema.StringAttribute{MarkdownDescription: "The DAG run ID.", Required: true}
+	attrs["task_id"] = schema.StringAttribute{MarkdownDescription: "The task ID.", Required: true}
+	attrs["map_index"] = schema.Int64Attribute{MarkdownDescription: "The map index of a mapped task instance. Leave unset for unmapped tasks.", Optional: true, Computed: true}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetches an Airflow task instance, e.g. to gate on a pipeline step having completed.",
+		Attributes:          attrs,
+	}
+}
+
+func (d *taskInstanceDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	cfg, ok := req.ProviderData.(client.ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.config = cfg
+}
+
+func (d *taskInstanceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data taskInstanceDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	mapIndex := int64(-1)
+	if !data.MapIndex.IsNull() && !data.MapIndex.IsUnknown() {
+		mapIndex = data.MapIndex.ValueInt64()
+	}
+
+	id := fmt.Sprintf("%s:%s:%s:%d", data.DagID.ValueString(), data.DagRunID.ValueString(), data.TaskID.ValueString(), mapIndex)
+	ti, httpResp, err := getTaskInstance(ctx, d.config, data.DagID.ValueString(), data.DagRunID.ValueString(), data.TaskID.ValueString(), mapIndex)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read Airflow task instance", clientError("read", id, httpResp, err))
+		return
+	}
+
+	data.taskInstanceModel = ti.toModel(&resp.Diagnostics)
+	data.ID = types.StringValue(id)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
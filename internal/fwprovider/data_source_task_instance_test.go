@@ -0,0 +1,69 @@
+package fwprovider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAirflowTaskInstanceDataSource_basic(t *testing.T) {
+	if os.Getenv("SKIP_AIRFLOW_DAG_TESTS") == "true" {
+		t.Skip("Skipping Airflow DAG tests")
+	}
+
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	dataSourceName := "data.airflow_task_instance.test"
+	listName := "data.airflow_task_instances.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckAirflowDagRunCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAirflowTaskInstanceDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "task_id", "runme_0"),
+					resource.TestCheckResourceAttr(dataSourceName, "map_index", "-1"),
+					resource.TestCheckResourceAttr(dataSourceName, "state", "success"),
+					resource.TestCheckResourceAttr(dataSourceName, "operator", "BashOperator"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "try_number"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "start_date"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "duration"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "pool"),
+					resource.TestCheckResourceAttrSet(listName, "task_instances.#"),
+					resource.TestCheckResourceAttr(listName, "task_instances.0.dag_run_id", rName),
+					resource.TestCheckResourceAttr(listName, "task_instances.0.state", "success"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAirflowTaskInstanceDataSourceConfig(dagRunId string) string {
+	return fmt.Sprintf(`
+resource "airflow_dag" "test" {
+  dag_id    = %[1]q
+  is_paused = false
+}
+
+resource "airflow_dag_run" "test" {
+  dag_id     = airflow_dag.test.dag_id
+  dag_run_id = %[2]q
+}
+
+data "airflow_task_instance" "test" {
+  dag_id     = airflow_dag_run.test.dag_id
+  dag_run_id = airflow_dag_run.test.dag_run_id
+  task_id    = "runme_0"
+}
+
+data "airflow_task_instances" "test" {
+  dag_id     = airflow_dag_run.test.dag_id
+  dag_run_id = airflow_dag_run.test.dag_run_id
+  state      = ["success"]
+}
+`, dagId, dagRunId)
+}
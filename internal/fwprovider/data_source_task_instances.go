@@ -0,0 +1,165 @@
+package fwprovider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/drfaust92/terraform-provider-airflow/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &taskInstancesDataSource{}
+	_ datasource.DataSourceWithConfigure = &taskInstancesDataSource{}
+)
+
+func newTaskInstancesDataSource() datasource.DataSource {
+	return &taskInstancesDataSource{}
+}
+
+type taskInstancesDataSource struct {
+	config client.ProviderConfig
+}
+
+type taskInstancesDataSourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	DagID          types.String `tfsdk:"dag_id"`
+	DagRunID       types.String `tfsdk:"dag_run_id"`
+	State          types.List   `tfsdk:"state"`
+	Pool           types.List   `tfsdk:"pool"`
+	Queue          types.List   `tfsdk:"queue"`
+	StartDateGte   types.String `tfsdk:"start_date_gte"`
+	StartDateLte   types.String `tfsdk:"start_date_lte"`
+	LogicalDateGte types.String `tfsdk:"logical_date_gte"`
+	LogicalDateLte types.String `tfsdk:"logical_date_lte"`
+	TaskInstances  types.List   `tfsdk:"task_instances"`
+}
+
+func (d *taskInstancesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_task_instances"
+}
+
+func (d *taskInstancesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	dateFilter := func(description string) schema.StringAttribute {
+		return schema.StringAttribute{
+			MarkdownDescription: description,
+			Optional:            true,
+			Validators: []validator.String{
+				rfc3339Validator{},
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists Airflow task instances, optionally across all DAGs or DAG runs.",
+		Attributes: map[string]schema.Attribute{
+			"id":               schema.StringAttribute{MarkdownDescription: "The identifier in the form `dag_id:dag_run_id`.", Computed: true},
+			"dag_id":           schema.StringAttribute{MarkdownDescription: "Only list task instances of this DAG. Defaults to all DAGs (`~`).", Optional: true},
+			"dag_run_id":       schema.StringAttribute{MarkdownDescription: "Only list task instances of this DAG run. Defaults to all DAG runs (`~`).", Optional: true},
+			"state":            schema.ListAttribute{MarkdownDescription: "Only list task instances in one of these states.", Optional: true, ElementType: types.StringType},
+			"pool":             schema.ListAttribute{MarkdownDescription: "Only list task instances in one of these pools.", Optional: true, ElementType: types.StringType},
+			"queue":            schema.ListAttribute{MarkdownDescription: "Only list task instances in one of these queues.", Optional: true, ElementType: types.StringType},
+			"start_date_gte":   dateFilter("Only list task instances that started at or after this RFC 3339 timestamp."),
+			"start_date_lte":   dateFilter("Only list task instances that started at or before this RFC 3339 timestamp."),
+			"logical_date_gte": dateFilter("Only list task instances of DAG runs with a logical date at or after this RFC 3339 timestamp."),
+			"logical_date_lte": dateFilter("Only list task instances of DAG runs with a logical date at or before this RFC 3339 timestamp."),
+			"task_instances": schema.ListNestedAttribute{
+				MarkdownDescription: "The matching task instances.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: taskInstanceSchemaAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (d *taskInstancesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	cfg, ok := req.ProviderData.(client.ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.config = cfg
+}
+
+func (d *taskInstancesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data taskInstancesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dagID := data.DagID.ValueString()
+	if dagID == "" {
+		dagID = "~"
+	}
+	dagRunID := data.DagRunID.ValueString()
+	if dagRunID == "" {
+		dagRunID = "~"
+	}
+
+	// API v2 renamed execution_date to logical_date.
+	logicalDateParam := "execution_date"
+	if d.config.IsV2() {
+		logicalDateParam = "logical_date"
+	}
+
+	query := url.Values{}
+	for param, list := range map[string]types.List{"state": data.State, "pool": data.Pool, "queue": data.Queue} {
+		if list.IsNull() || list.IsUnknown() {
+			continue
+		}
+		var values []string
+		resp.Diagnostics.Append(list.ElementsAs(ctx, &values, false)...)
+		query[param] = values
+	}
+	for param, v := range map[string]types.String{
+		"start_date_gte":          data.StartDateGte,
+		"start_date_lte":          data.StartDateLte,
+		logicalDateParam + "_gte": data.LogicalDateGte,
+		logicalDateParam + "_lte": data.LogicalDateLte,
+	} {
+		if s := v.ValueString(); s != "" {
+			query.Set(param, s)
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := fmt.Sprintf("%s:%s", dagID, dagRunID)
+	tis, httpResp, err := listTaskInstances(ctx, d.config, dagID, dagRunID, query)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list Airflow task instances", clientError("list", id, httpResp, err))
+		return
+	}
+
+	values := make([]attr.Value, 0, len(tis))
+	for i := range tis {
+		v, diags := types.ObjectValueFrom(ctx, taskInstanceAttrTypes, tis[i].toModel(&resp.Diagnostics))
+		resp.Diagnostics.Append(diags...)
+		values = append(values, v)
+	}
+	taskInstances, diags := types.ListValue(types.ObjectType{AttrTypes: taskInstanceAttrTypes}, values)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(id)
+	data.TaskInstances = taskInstances
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
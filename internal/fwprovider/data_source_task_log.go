@@ -0,0 +1,130 @@
+package fwprovider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/drfaust92/terraform-provider-airflow/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &taskLogDataSource{}
+	_ datasource.DataSourceWithConfigure = &taskLogDataSource{}
+)
+
+func newTaskLogDataSource() datasource.DataSource {
+	return &taskLogDataSource{}
+}
+
+type taskLogDataSource struct {
+	config client.ProviderConfig
+}
+
+type taskLogDataSourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	DagID       types.String `tfsdk:"dag_id"`
+	DagRunID    types.String `tfsdk:"dag_run_id"`
+	TaskID      types.String `tfsdk:"task_id"`
+	MapIndex    types.Int64  `tfsdk:"map_index"`
+	TryNumber   types.Int64  `tfsdk:"try_number"`
+	FullContent types.Bool   `tfsdk:"full_content"`
+	TailLines   types.Int64  `tfsdk:"tail_lines"`
+	Content     types.String `tfsdk:"content"`
+}
+
+func (d *taskLogDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_task_log"
+}
+
+func (d *taskLogDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetches the log of an Airflow task instance try, e.g. to surface a failing task's log in CI.",
+		Attributes: map[string]schema.Attribute{
+			"id":         schema.StringAttribute{MarkdownDescription: "The identifier in the form `dag_id:dag_run_id:task_id:map_index:try_number`.", Computed: true},
+			"dag_id":     schema.StringAttribute{MarkdownDescription: "The DAG ID.", Required: true},
+			"dag_run_id": schema.StringAttribute{MarkdownDescription: "The DAG run ID.", Required: true},
+			"task_id":    schema.StringAttribute{MarkdownDescription: "The task ID.", Required: true},
+			"map_index":  schema.Int64Attribute{MarkdownDescription: "The map index of a mapped task instance. Leave unset for unmapped tasks.", Optional: true},
+			"try_number": schema.Int64Attribute{
+				MarkdownDescription: "The try to fetch the log of. Defaults to the task instance's latest try.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"full_content": schema.BoolAttribute{MarkdownDescription: "Fetch the full log rather than letting Airflow pick the chunk to return. Continuation tokens are followed either way.", Optional: true},
+			"tail_lines": schema.Int64Attribute{
+				MarkdownDescription: "Only return the last lines of the log.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"content": schema.StringAttribute{MarkdownDescription: "The log text. Airflow 3 structured log lines are rendered as `[timestamp] LEVEL - event`.", Computed: true},
+		},
+	}
+}
+
+func (d *taskLogDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	cfg, ok := req.ProviderData.(client.ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.config = cfg
+}
+
+func (d *taskLogDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data taskLogDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dagID, dagRunID, taskID := data.DagID.ValueString(), data.DagRunID.ValueString(), data.TaskID.ValueString()
+	mapIndex := int64(-1)
+	if !data.MapIndex.IsNull() && !data.MapIndex.IsUnknown() {
+		mapIndex = data.MapIndex.ValueInt64()
+	}
+
+	tryNumber := data.TryNumber.ValueInt64()
+	if data.TryNumber.IsNull() || data.TryNumber.IsUnknown() {
+		ti, httpResp, err := getTaskInstance(ctx, d.config, dagID, dagRunID, taskID, mapIndex)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to read Airflow task instance", clientError("read", fmt.Sprintf("%s:%s:%s", dagID, dagRunID, taskID), httpResp, err))
+			return
+		}
+		tryNumber = ti.TryNumber
+		if tryNumber < 1 {
+			tryNumber = 1
+		}
+	}
+
+	id := fmt.Sprintf("%s:%s:%s:%d:%d", dagID, dagRunID, taskID, mapIndex, tryNumber)
+	content, httpResp, err := getTaskLog(ctx, d.config, dagID, dagRunID, taskID, tryNumber, mapIndex, data.FullContent.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read Airflow task log", clientError("read", id, httpResp, err))
+		return
+	}
+	if !data.TailLines.IsNull() {
+		content = tailLines(content, int(data.TailLines.ValueInt64()))
+	}
+
+	data.ID = types.StringValue(id)
+	data.TryNumber = types.Int64Value(tryNumber)
+	data.Content = types.StringValue(content)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,55 @@
+package fwprovider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAirflowTaskLogDataSource_basic(t *testing.T) {
+	if os.Getenv("SKIP_AIRFLOW_DAG_TESTS") == "true" {
+		t.Skip("Skipping Airflow DAG tests")
+	}
+
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	dataSourceName := "data.airflow_task_log.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckAirflowDagRunCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAirflowTaskLogDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "try_number", "1"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "content"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAirflowTaskLogDataSourceConfig(dagRunId string) string {
+	return fmt.Sprintf(`
+resource "airflow_dag" "test" {
+  dag_id    = %[1]q
+  is_paused = false
+}
+
+resource "airflow_dag_run" "test" {
+  dag_id     = airflow_dag.test.dag_id
+  dag_run_id = %[2]q
+}
+
+data "airflow_task_log" "test" {
+  dag_id       = airflow_dag_run.test.dag_id
+  dag_run_id   = airflow_dag_run.test.dag_run_id
+  task_id      = "runme_0"
+  full_content = true
+  tail_lines   = 50
+}
+`, dagId, dagRunId)
+}
@@ -0,0 +1,99 @@
+package fwprovider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/drfaust92/terraform-provider-airflow/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &xcomDataSource{}
+	_ datasource.DataSourceWithConfigure = &xcomDataSource{}
+)
+
+func newXComDataSource() datasource.DataSource {
+	return &xcomDataSource{}
+}
+
+type xcomDataSource struct {
+	config client.ProviderConfig
+}
+
+type xcomDataSourceModel struct {
+	ID        types.String  `tfsdk:"id"`
+	DagID     types.String  `tfsdk:"dag_id"`
+	DagRunID  types.String  `tfsdk:"dag_run_id"`
+	TaskID    types.String  `tfsdk:"task_id"`
+	Key       types.String  `tfsdk:"key"`
+	MapIndex  types.Int64   `tfsdk:"map_index"`
+	Value     types.String  `tfsdk:"value"`
+	ValueJSON types.Dynamic `tfsdk:"value_json"`
+	Timestamp types.String  `tfsdk:"timestamp"`
+}
+
+func (d *xcomDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_xcom"
+}
+
+func (d *xcomDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetches an XCom value pushed by an Airflow task, e.g. to consume the output of a DAG run triggered by `airflow_dag_run`.",
+		Attributes: map[string]schema.Attribute{
+			"id":         schema.StringAttribute{MarkdownDescription: "The XCom identifier in the form `dag_id:dag_run_id:task_id:map_index:key`.", Computed: true},
+			"dag_id":     schema.StringAttribute{MarkdownDescription: "The DAG ID.", Required: true},
+			"dag_run_id": schema.StringAttribute{MarkdownDescription: "The DAG run ID.", Required: true},
+			"task_id":    schema.StringAttribute{MarkdownDescription: "The ID of the task that pushed the XCom.", Required: true},
+			"key":        schema.StringAttribute{MarkdownDescription: "The XCom key, e.g. `return_value` for a task's return value.", Required: true},
+			"map_index":  schema.Int64Attribute{MarkdownDescription: "The map index of a mapped task instance. Defaults to `-1` (unmapped).", Optional: true, Computed: true},
+			"value":      schema.StringAttribute{MarkdownDescription: "The value as a string: string values as is, other values JSON-encoded.", Computed: true},
+			"value_json": schema.DynamicAttribute{MarkdownDescription: "The value parsed from JSON, like `jsondecode(value)`. A string value holding JSON is parsed too; any other string is returned as is.", Computed: true},
+			"timestamp":  schema.StringAttribute{MarkdownDescription: "When the XCom was pushed.", Computed: true},
+		},
+	}
+}
+
+func (d *xcomDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	cfg, ok := req.ProviderData.(client.ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.config = cfg
+}
+
+func (d *xcomDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data xcomDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	mapIndex := int64(-1)
+	if !data.MapIndex.IsNull() && !data.MapIndex.IsUnknown() {
+		mapIndex = data.MapIndex.ValueInt64()
+	}
+
+	id := fmt.Sprintf("%s:%s:%s:%d:%s", data.DagID.ValueString(), data.DagRunID.ValueString(), data.TaskID.ValueString(), mapIndex, data.Key.ValueString())
+	entry, httpResp, err := getXCom(ctx, d.config, data.DagID.ValueString(), data.DagRunID.ValueString(), data.TaskID.ValueString(), data.Key.ValueString(), mapIndex)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read Airflow XCom", clientError("read", id, httpResp, err))
+		return
+	}
+
+	data.ID = types.StringValue(id)
+	data.MapIndex = types.Int64Value(mapIndex)
+	data.Value, data.ValueJSON = xcomValue(ctx, entry.Value)
+	data.Timestamp = types.StringValue(entry.Timestamp)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,66 @@
+package fwprovider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAirflowXComDataSource_basic(t *testing.T) {
+	if os.Getenv("SKIP_AIRFLOW_DAG_TESTS") == "true" {
+		t.Skip("Skipping Airflow DAG tests")
+	}
+
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	dataSourceName := "data.airflow_xcom.test"
+	listName := "data.airflow_xcoms.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckAirflowDagRunCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAirflowXComDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "key", "return_value"),
+					resource.TestCheckResourceAttr(dataSourceName, "map_index", "-1"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "value"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "timestamp"),
+					resource.TestCheckResourceAttr(listName, "xcoms.#", "1"),
+					resource.TestCheckResourceAttr(listName, "xcoms.0.key", "return_value"),
+					resource.TestCheckResourceAttr(listName, "xcoms.0.dag_run_id", rName),
+				),
+			},
+		},
+	})
+}
+
+func testAccAirflowXComDataSourceConfig(dagRunId string) string {
+	return fmt.Sprintf(`
+resource "airflow_dag" "test" {
+  dag_id    = %[1]q
+  is_paused = false
+}
+
+resource "airflow_dag_run" "test" {
+  dag_id     = airflow_dag.test.dag_id
+  dag_run_id = %[2]q
+}
+
+data "airflow_xcom" "test" {
+  dag_id     = airflow_dag_run.test.dag_id
+  dag_run_id = airflow_dag_run.test.dag_run_id
+  task_id    = "runme_0"
+  key        = "return_value"
+}
+
+data "airflow_xcoms" "test" {
+  dag_id     = airflow_dag_run.test.dag_id
+  dag_run_id = airflow_dag_run.test.dag_run_id
+  task_id    = "runme_0"
+}
+`, dagId, dagRunId)
+}
@@ -0,0 +1,155 @@
+package fwprovider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/drfaust92/terraform-provider-airflow/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &xcomsDataSource{}
+	_ datasource.DataSourceWithConfigure = &xcomsDataSource{}
+)
+
+func newXComsDataSource() datasource.DataSource {
+	return &xcomsDataSource{}
+}
+
+type xcomsDataSource struct {
+	config client.ProviderConfig
+}
+
+type xcomsDataSourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	DagID    types.String `tfsdk:"dag_id"`
+	DagRunID types.String `tfsdk:"dag_run_id"`
+	TaskID   types.String `tfsdk:"task_id"`
+	Key      types.String `tfsdk:"key"`
+	MapIndex types.Int64  `tfsdk:"map_index"`
+	XComs    types.List   `tfsdk:"xcoms"`
+}
+
+var xcomAttrTypes = map[string]attr.Type{
+	"dag_id":     types.StringType,
+	"dag_run_id": types.StringType,
+	"task_id":    types.StringType,
+	"key":        types.StringType,
+	"map_index":  types.Int64Type,
+	"timestamp":  types.StringType,
+}
+
+func (d *xcomsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_xcoms"
+}
+
+func (d *xcomsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the XCom entries pushed by Airflow tasks. Values are not included; read them with the `airflow_xcom` data source.",
+		Attributes: map[string]schema.Attribute{
+			"id":         schema.StringAttribute{MarkdownDescription: "The identifier in the form `dag_id:dag_run_id:task_id`.", Computed: true},
+			"dag_id":     schema.StringAttribute{MarkdownDescription: "The DAG ID.", Required: true},
+			"dag_run_id": schema.StringAttribute{MarkdownDescription: "Only list XComs of this DAG run. Defaults to all DAG runs (`~`).", Optional: true},
+			"task_id":    schema.StringAttribute{MarkdownDescription: "Only list XComs pushed by this task. Defaults to all tasks (`~`).", Optional: true},
+			"key":        schema.StringAttribute{MarkdownDescription: "Only list XComs with this key.", Optional: true},
+			"map_index":  schema.Int64Attribute{MarkdownDescription: "Only list XComs of this map index.", Optional: true},
+			"xcoms": schema.ListNestedAttribute{
+				MarkdownDescription: "The matching XCom entries.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"dag_id":     schema.StringAttribute{MarkdownDescription: "The DAG ID.", Computed: true},
+						"dag_run_id": schema.StringAttribute{MarkdownDescription: "The DAG run ID. Not reported by Airflow 2 when listing across DAG runs.", Computed: true},
+						"task_id":    schema.StringAttribute{MarkdownDescription: "The ID of the task that pushed the XCom.", Computed: true},
+						"key":        schema.StringAttribute{MarkdownDescription: "The XCom key.", Computed: true},
+						"map_index":  schema.Int64Attribute{MarkdownDescription: "The map index of the task instance.", Computed: true},
+						"timestamp":  schema.StringAttribute{MarkdownDescription: "When the XCom was pushed.", Computed: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *xcomsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	cfg, ok := req.ProviderData.(client.ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.config = cfg
+}
+
+func (d *xcomsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data xcomsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dagID := data.DagID.ValueString()
+	dagRunID := data.DagRunID.ValueString()
+	if dagRunID == "" {
+		dagRunID = "~"
+	}
+	taskID := data.TaskID.ValueString()
+	if taskID == "" {
+		taskID = "~"
+	}
+
+	query := url.Values{}
+	if v := data.Key.ValueString(); v != "" {
+		query.Set("xcom_key", v)
+	}
+	if !data.MapIndex.IsNull() && !data.MapIndex.IsUnknown() {
+		query.Set("map_index", strconv.FormatInt(data.MapIndex.ValueInt64(), 10))
+	}
+
+	id := fmt.Sprintf("%s:%s:%s", dagID, dagRunID, taskID)
+	entries, httpResp, err := listXComs(ctx, d.config, dagID, dagRunID, taskID, query)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list Airflow XComs", clientError("list", id, httpResp, err))
+		return
+	}
+
+	values := make([]attr.Value, 0, len(entries))
+	for _, e := range entries {
+		runID := e.RunID
+		if runID == "" && dagRunID != "~" {
+			runID = dagRunID
+		}
+
+		v, diags := types.ObjectValue(xcomAttrTypes, map[string]attr.Value{
+			"dag_id":     types.StringValue(e.DagID),
+			"dag_run_id": types.StringValue(runID),
+			"task_id":    types.StringValue(e.TaskID),
+			"key":        types.StringValue(e.Key),
+			"map_index":  types.Int64Value(e.MapIndex),
+			"timestamp":  types.StringValue(e.Timestamp),
+		})
+		resp.Diagnostics.Append(diags...)
+		values = append(values, v)
+	}
+	xcoms, diags := types.ListValue(types.ObjectType{AttrTypes: xcomAttrTypes}, values)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(id)
+	data.XComs = xcoms
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,167 @@
+package fwprovider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// jsonToDynamic converts a JSON document into a dynamic Terraform value:
+// objects become objects, arrays become tuples and numbers stay numbers, so
+// the value can be used in configuration just like the result of jsondecode.
+func jsonToDynamic(ctx context.Context, raw []byte) (types.Dynamic, error) {
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return types.DynamicNull(), err
+	}
+
+	value, err := jsonToAttrValue(ctx, v)
+	if err != nil {
+		return types.DynamicNull(), err
+	}
+	return types.DynamicValue(value), nil
+}
+
+func jsonToAttrValue(ctx context.Context, v interface{}) (attr.Value, error) {
+	switch v := v.(type) {
+	case nil:
+		// A typed null: nested dynamic values cannot be sent to Terraform.
+		return types.StringNull(), nil
+	case bool:
+		return types.BoolValue(v), nil
+	case string:
+		return types.StringValue(v), nil
+	case json.Number:
+		f, _, err := big.ParseFloat(string(v), 10, 512, big.ToNearestEven)
+		if err != nil {
+			return nil, err
+		}
+		return types.NumberValue(f), nil
+	case []interface{}:
+		elemTypes := make([]attr.Type, 0, len(v))
+		elems := make([]attr.Value, 0, len(v))
+		for _, item := range v {
+			elem, err := jsonToAttrValue(ctx, item)
+			if err != nil {
+				return nil, err
+			}
+			elemTypes = append(elemTypes, elem.Type(ctx))
+			elems = append(elems, elem)
+		}
+		tuple, diags := types.TupleValue(elemTypes, elems)
+		if diags.HasError() {
+			return nil, fmt.Errorf("failed to build tuple: %v", diags)
+		}
+		return tuple, nil
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		attrTypes := make(map[string]attr.Type, len(v))
+		attrs := make(map[string]attr.Value, len(v))
+		for _, k := range keys {
+			value, err := jsonToAttrValue(ctx, v[k])
+			if err != nil {
+				return nil, err
+			}
+			attrTypes[k] = value.Type(ctx)
+			attrs[k] = value
+		}
+		object, diags := types.ObjectValue(attrTypes, attrs)
+		if diags.HasError() {
+			return nil, fmt.Errorf("failed to build object: %v", diags)
+		}
+		return object, nil
+	default:
+		return nil, fmt.Errorf("unsupported JSON value %T", v)
+	}
+}
+
+// dynamicToJSON is the inverse of jsonToDynamic: it encodes a dynamic value
+// (any object, map, list, tuple, set or primitive) as JSON.
+func dynamicToJSON(v types.Dynamic) ([]byte, error) {
+	if v.IsNull() {
+		return []byte("null"), nil
+	}
+	if v.IsUnknown() || v.IsUnderlyingValueUnknown() {
+		return nil, fmt.Errorf("value is not yet known")
+	}
+
+	data, err := attrValueToJSON(v.UnderlyingValue())
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(data)
+}
+
+func attrValueToJSON(v attr.Value) (interface{}, error) {
+	if v == nil || v.IsNull() {
+		return nil, nil
+	}
+	if v.IsUnknown() {
+		return nil, fmt.Errorf("value is not yet known")
+	}
+
+	switch v := v.(type) {
+	case types.Dynamic:
+		return attrValueToJSON(v.UnderlyingValue())
+	case types.String:
+		return v.ValueString(), nil
+	case types.Bool:
+		return v.ValueBool(), nil
+	case types.Int64:
+		return v.ValueInt64(), nil
+	case types.Float64:
+		return v.ValueFloat64(), nil
+	case types.Number:
+		return json.Number(v.ValueBigFloat().Text('g', -1)), nil
+	case types.List:
+		return attrValuesToJSON(v.Elements())
+	case types.Set:
+		return attrValuesToJSON(v.Elements())
+	case types.Tuple:
+		return attrValuesToJSON(v.Elements())
+	case types.Map:
+		return attrValueMapToJSON(v.Elements())
+	case types.Object:
+		return attrValueMapToJSON(v.Attributes())
+	default:
+		return nil, fmt.Errorf("unsupported value type %T", v)
+	}
+}
+
+func attrValuesToJSON(elems []attr.Value) (interface{}, error) {
+	out := make([]interface{}, 0, len(elems))
+	for _, elem := range elems {
+		v, err := attrValueToJSON(elem)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, v)
+	}
+	return out, nil
+}
+
+func attrValueMapToJSON(elems map[string]attr.Value) (interface{}, error) {
+	out := make(map[string]interface{}, len(elems))
+	for k, elem := range elems {
+		v, err := attrValueToJSON(elem)
+		if err != nil {
+			return nil, err
+		}
+		out[k] = v
+	}
+	return out, nil
+}
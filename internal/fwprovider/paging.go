@@ -0,0 +1,63 @@
+package fwprovider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/drfaust92/terraform-provider-airflow/internal/client"
+)
+
+// listPageSize is the page size used by listPages; Airflow caps pages at 100
+// by default.
+const listPageSize = 100
+
+// listPages pages through the items that GET path returns under key for
+// query, in API order, stopping once limit items (if positive) were
+// collected. keep, when non-nil, filters the items of each page, e.g. on
+// fields the API cannot filter on.
+func listPages[T any](ctx context.Context, cfg client.ProviderConfig, path string, query url.Values, key string, keep func(T) bool, limit int) ([]T, *http.Response, error) {
+	var items []T
+	for offset := 0; ; offset += listPageSize {
+		q := cloneValues(query)
+		q.Set("limit", strconv.Itoa(listPageSize))
+		q.Set("offset", strconv.Itoa(offset))
+
+		var page map[string]json.RawMessage
+		httpResp, err := cfg.Do(ctx, http.MethodGet, path, q, nil, &page)
+		if err != nil {
+			return nil, httpResp, err
+		}
+		var pageItems []T
+		if raw, ok := page[key]; ok {
+			if err := json.Unmarshal(raw, &pageItems); err != nil {
+				return nil, httpResp, fmt.Errorf("failed to decode %s from %s: %w", key, path, err)
+			}
+		}
+
+		for _, item := range pageItems {
+			if keep != nil && !keep(item) {
+				continue
+			}
+			items = append(items, item)
+			if limit > 0 && len(items) == limit {
+				return items, httpResp, nil
+			}
+		}
+		if len(pageItems) < listPageSize {
+			return items, httpResp, nil
+		}
+	}
+}
+
+// cloneValues returns a deep copy of v.
+func cloneValues(v url.Values) url.Values {
+	c := make(url.Values, len(v))
+	for k, vs := range v {
+		c[k] = append([]string(nil), vs...)
+	}
+	return c
+}
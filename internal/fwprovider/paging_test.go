@@ -0,0 +1,68 @@
+package fwprovider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+
+	"github.com/drfaust92/terraform-provider-airflow/internal/client"
+)
+
+func TestListPages(t *testing.T) {
+	const total = 250
+	var requests []url.Values
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		q := req.URL.Query()
+		requests = append(requests, q)
+		offset, _ := strconv.Atoi(q.Get("offset"))
+		limit, _ := strconv.Atoi(q.Get("limit"))
+		items := []map[string]int{}
+		for i := offset; i < total && i < offset+limit; i++ {
+			items = append(items, map[string]int{"n": i})
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"items": items, "total_entries": total})
+	}))
+	defer srv.Close()
+
+	cfg, err := client.NewProviderConfig(srv.URL, "", "", "", false, "/api/v1", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	type item struct {
+		N int `json:"n"`
+	}
+	ctx := context.Background()
+	query := url.Values{"order_by": {"n"}}
+
+	items, _, err := listPages[item](ctx, cfg, "/items", query, "items", nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(items) != total || items[total-1].N != total-1 {
+		t.Errorf("listed %d items, want %d", len(items), total)
+	}
+	if len(requests) != 3 || requests[2].Get("offset") != "200" || requests[2].Get("order_by") != "n" {
+		t.Errorf("unexpected requests %v", requests)
+	}
+	if len(query) != 1 {
+		t.Errorf("query was modified: %v", query)
+	}
+
+	requests = nil
+	even := func(i item) bool { return i.N%2 == 0 }
+	items, _, err = listPages[item](ctx, cfg, "/items", nil, "items", even, 60)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(items) != 60 || items[59].N != 118 {
+		t.Errorf("listed %v, want the first 60 even items", items)
+	}
+	if len(requests) != 2 {
+		t.Errorf("%d requests, want 2: paging must stop at the limit", len(requests))
+	}
+}
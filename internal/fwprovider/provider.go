@@ -137,7 +137,20 @@ func (p *airflowProvider) Resources(_ context.Context) []func() resource.Resourc
 		newUserRolesResource,
 		newDagResource,
 		newDagRunResource,
+		newDagsPausedResource,
+		newDagReparseResource,
+		newBackfillResource,
+		newAssetEventResource,
+		newTaskInstancesClearResource,
 		newConnectionResource,
+		newAWSConnectionResource,
+		newGoogleCloudConnectionResource,
+		newPostgresConnectionResource,
+		newSnowflakeConnectionResource,
+		newDatabricksConnectionResource,
+		newKubernetesConnectionResource,
+		newDefaultConnectionsResource,
+		newConnectionsExclusiveResource,
 	}
 }
 
@@ -145,8 +158,20 @@ func (p *airflowProvider) DataSources(_ context.Context) []func() datasource.Dat
 	return []func() datasource.DataSource{
 		newVariableDataSource,
 		newConnectionDataSource,
+		newConnectionsDataSource,
+		newConnectionsFileDataSource,
 		newPoolDataSource,
 		newDagDataSource,
+		newDagRunDataSource,
+		newDagRunsDataSource,
+		newAssetEventsDataSource,
+		newAssetDataSource,
+		newAssetsDataSource,
+		newTaskInstanceDataSource,
+		newTaskInstancesDataSource,
+		newXComDataSource,
+		newXComsDataSource,
+		newTaskLogDataSource,
 	}
 }
 
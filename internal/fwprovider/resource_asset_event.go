@@ -0,0 +1,175 @@
+package fwprovider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/drfaust92/terraform-provider-airflow/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource              = &assetEventResource{}
+	_ resource.ResourceWithConfigure = &assetEventResource{}
+)
+
+func newAssetEventResource() resource.Resource {
+	return &assetEventResource{}
+}
+
+type assetEventResource struct {
+	config client.ProviderConfig
+}
+
+type assetEventResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	URI       types.String `tfsdk:"uri"`
+	Extra     types.String `tfsdk:"extra"`
+	Triggers  types.Map    `tfsdk:"triggers"`
+	AssetID   types.Int64  `tfsdk:"asset_id"`
+	Timestamp types.String `tfsdk:"timestamp"`
+}
+
+func (r *assetEventResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_asset_event"
+}
+
+func (r *assetEventResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Emits an Airflow asset event (a dataset event on Airflow 2), which schedules the DAGs consuming the asset. The event is emitted on create and again whenever `triggers` change; events cannot be deleted, so destroying the resource only removes it from state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The event ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"uri": schema.StringAttribute{
+				MarkdownDescription: "The URI of the asset the event is for. The asset must already be known to Airflow, i.e. referenced by a parsed DAG.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"extra": schema.StringAttribute{
+				MarkdownDescription: "A JSON object of extra information attached to the event.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary values that emit a new event when changed.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"asset_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the asset.",
+				Computed:            true,
+			},
+			"timestamp": schema.StringAttribute{
+				MarkdownDescription: "When the event was emitted.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *assetEventResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	cfg, ok := req.ProviderData.(client.ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.config = cfg
+}
+
+func (r *assetEventResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan assetEventResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var extra map[string]interface{}
+	if v := plan.Extra.ValueString(); v != "" {
+		if err := json.Unmarshal([]byte(v), &extra); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("extra"),
+				"Invalid extra",
+				fmt.Sprintf("extra must be a JSON object: %s", err),
+			)
+			return
+		}
+	}
+
+	uri := plan.URI.ValueString()
+	a, httpResp, err := getAssetByURI(ctx, r.config, uri)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read Airflow asset", clientError("read", uri, httpResp, err))
+		return
+	}
+	if a == nil {
+		resp.Diagnostics.AddError("Airflow asset not found", fmt.Sprintf("No asset with URI %q is known to Airflow. Assets are registered when a DAG referencing them is parsed.", uri))
+		return
+	}
+
+	event, httpResp, err := createAssetEvent(ctx, r.config, a, extra)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create Airflow asset event", clientError("create", uri, httpResp, err))
+		return
+	}
+
+	plan.ID = types.StringValue(strconv.FormatInt(event.ID, 10))
+	plan.AssetID = types.Int64Value(a.ID)
+	plan.Timestamp = types.StringValue(event.Timestamp)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read keeps the prior state: events are immutable and cannot be fetched
+// individually.
+func (r *assetEventResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state assetEventResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update is never called with changes: every configurable attribute uses
+// RequiresReplace.
+func (r *assetEventResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan assetEventResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *assetEventResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
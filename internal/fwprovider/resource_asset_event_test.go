@@ -0,0 +1,63 @@
+package fwprovider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// testAccAssetURI is produced by the example dataset/asset DAGs shipped with
+// Airflow.
+const testAccAssetURI = "s3://dag1/output_1.txt"
+
+func TestAccAirflowAssetEvent_basic(t *testing.T) {
+	if os.Getenv("SKIP_AIRFLOW_DAG_TESTS") == "true" {
+		t.Skip("Skipping Airflow DAG tests")
+	}
+
+	resourceName := "airflow_asset_event.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAirflowAssetEventConfig("1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "asset_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "timestamp"),
+				),
+			},
+			{
+				Config: testAccAirflowAssetEventConfig("2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "triggers.version", "2"),
+					resource.TestCheckResourceAttrPair("data.airflow_asset_events.test", "events.0.id", resourceName, "id"),
+					resource.TestCheckResourceAttr("data.airflow_asset_events.test", "events.0.extra", `{"version":"2"}`),
+				),
+			},
+		},
+	})
+}
+
+func testAccAirflowAssetEventConfig(version string) string {
+	return fmt.Sprintf(`
+resource "airflow_asset_event" "test" {
+  uri   = %[1]q
+  extra = jsonencode({ version = %[2]q })
+
+  triggers = {
+    version = %[2]q
+  }
+}
+
+data "airflow_asset_events" "test" {
+  uri   = airflow_asset_event.test.uri
+  limit = 5
+
+  depends_on = [airflow_asset_event.test]
+}
+`, testAccAssetURI, version)
+}
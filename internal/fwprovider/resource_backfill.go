@@ -0,0 +1,521 @@
+package fwprovider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/drfaust92/terraform-provider-airflow/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &backfillResource{}
+	_ resource.ResourceWithConfigure   = &backfillResource{}
+	_ resource.ResourceWithImportState = &backfillResource{}
+)
+
+func newBackfillResource() resource.Resource {
+	return &backfillResource{}
+}
+
+type backfillResource struct {
+	config client.ProviderConfig
+}
+
+type backfillResourceModel struct {
+	ID                types.String   `tfsdk:"id"`
+	DagID             types.String   `tfsdk:"dag_id"`
+	FromDate          types.String   `tfsdk:"from_date"`
+	ToDate            types.String   `tfsdk:"to_date"`
+	ReprocessBehavior types.String   `tfsdk:"reprocess_behavior"`
+	MaxActiveRuns     types.Int64    `tfsdk:"max_active_runs"`
+	RunBackwards      types.Bool     `tfsdk:"run_backwards"`
+	DagRunConf        types.Map      `tfsdk:"dag_run_conf"`
+	Paused            types.Bool     `tfsdk:"paused"`
+	WaitForCompletion types.Bool     `tfsdk:"wait_for_completion"`
+	CreatedAt         types.String   `tfsdk:"created_at"`
+	CompletedAt       types.String   `tfsdk:"completed_at"`
+	TotalRuns         types.Int64    `tfsdk:"total_runs"`
+	QueuedRuns        types.Int64    `tfsdk:"queued_runs"`
+	RunningRuns       types.Int64    `tfsdk:"running_runs"`
+	SuccessRuns       types.Int64    `tfsdk:"success_runs"`
+	FailedRuns        types.Int64    `tfsdk:"failed_runs"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
+}
+
+// backfill is the API v2 backfill object.
+type backfill struct {
+	ID                int64                  `json:"id"`
+	DagID             string                 `json:"dag_id"`
+	FromDate          string                 `json:"from_date"`
+	ToDate            string                 `json:"to_date"`
+	DagRunConf        map[string]interface{} `json:"dag_run_conf"`
+	IsPaused          bool                   `json:"is_paused"`
+	ReprocessBehavior string                 `json:"reprocess_behavior"`
+	MaxActiveRuns     int64                  `json:"max_active_runs"`
+	CreatedAt         string                 `json:"created_at"`
+	CompletedAt       *string                `json:"completed_at"`
+}
+
+type backfillPostBody struct {
+	DagID             string                 `json:"dag_id"`
+	FromDate          string                 `json:"from_date"`
+	ToDate            string                 `json:"to_date"`
+	RunBackwards      bool                   `json:"run_backwards"`
+	DagRunConf        map[string]interface{} `json:"dag_run_conf,omitempty"`
+	ReprocessBehavior string                 `json:"reprocess_behavior"`
+	MaxActiveRuns     int64                  `json:"max_active_runs"`
+}
+
+func (r *backfillResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_backfill"
+}
+
+func (r *backfillResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Provides an Airflow backfill resource, which creates DAG runs for every schedule interval between two dates. Requires Airflow 3 (API v2). Destroying the resource cancels the backfill if it has not completed; DAG runs it already created are kept.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The backfill ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"dag_id": schema.StringAttribute{
+				MarkdownDescription: "The DAG ID to backfill.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"from_date": schema.StringAttribute{
+				MarkdownDescription: "The start of the backfill range, as an RFC 3339 timestamp.",
+				Required:            true,
+				Validators: []validator.String{
+					rfc3339Validator{},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"to_date": schema.StringAttribute{
+				MarkdownDescription: "The end of the backfill range, as an RFC 3339 timestamp.",
+				Required:            true,
+				Validators: []validator.String{
+					rfc3339Validator{},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"reprocess_behavior": schema.StringAttribute{
+				MarkdownDescription: "Which existing DAG runs in the range are run again: `none` (the default), `failed` or `completed`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("none"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("none", "failed", "completed"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"max_active_runs": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of backfill DAG runs running at once. Defaults to `10`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(10),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"run_backwards": schema.BoolAttribute{
+				MarkdownDescription: "Create the DAG runs from the most recent interval backwards. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"dag_run_conf": schema.MapAttribute{
+				MarkdownDescription: "A map describing additional configuration parameters passed to every backfill DAG run.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"paused": schema.BoolAttribute{
+				MarkdownDescription: "Whether the backfill is paused. Can be updated in place. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"wait_for_completion": schema.BoolAttribute{
+				MarkdownDescription: "Wait for the backfill to complete on create, within the `create` timeout. Failed DAG runs are reported as a warning. Ignored when `paused` is set.",
+				Optional:            true,
+			},
+			"created_at":   schema.StringAttribute{MarkdownDescription: "When the backfill was created.", Computed: true},
+			"completed_at": schema.StringAttribute{MarkdownDescription: "When the backfill completed or was cancelled, empty while it is in progress.", Computed: true},
+			"total_runs":   schema.Int64Attribute{MarkdownDescription: "The number of backfill DAG runs created so far.", Computed: true},
+			"queued_runs":  schema.Int64Attribute{MarkdownDescription: "The number of queued backfill DAG runs.", Computed: true},
+			"running_runs": schema.Int64Attribute{MarkdownDescription: "The number of running backfill DAG runs.", Computed: true},
+			"success_runs": schema.Int64Attribute{MarkdownDescription: "The number of successful backfill DAG runs.", Computed: true},
+			"failed_runs":  schema.Int64Attribute{MarkdownDescription: "The number of failed backfill DAG runs.", Computed: true},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true}),
+		},
+	}
+}
+
+func (r *backfillResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	cfg, ok := req.ProviderData.(client.ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.config = cfg
+}
+
+func (r *backfillResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan backfillResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !r.config.IsV2() {
+		resp.Diagnostics.AddError("Unsupported Airflow version", "Backfills require Airflow 3 (API v2).")
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, 60*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	start := time.Now()
+
+	body := backfillPostBody{
+		DagID:             plan.DagID.ValueString(),
+		FromDate:          plan.FromDate.ValueString(),
+		ToDate:            plan.ToDate.ValueString(),
+		RunBackwards:      plan.RunBackwards.ValueBool(),
+		DagRunConf:        expandConf(ctx, plan.DagRunConf, &resp.Diagnostics),
+		ReprocessBehavior: plan.ReprocessBehavior.ValueString(),
+		MaxActiveRuns:     plan.MaxActiveRuns.ValueInt64(),
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var res backfill
+	httpResp, err := r.config.Do(ctx, http.MethodPost, "/backfills", nil, body, &res)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create Airflow backfill", clientError("create", body.DagID, httpResp, err))
+		return
+	}
+
+	id := strconv.FormatInt(res.ID, 10)
+	plan.ID = types.StringValue(id)
+
+	// The create endpoint always starts the backfill unpaused.
+	if plan.Paused.ValueBool() {
+		if !r.setPaused(ctx, id, true, &resp.Diagnostics) {
+			return
+		}
+	} else if plan.WaitForCompletion.ValueBool() {
+		if !r.waitForCompletion(ctx, id, createTimeout-time.Since(start), &resp.Diagnostics) {
+			return
+		}
+	}
+
+	if found := r.readInto(ctx, &plan, &resp.Diagnostics); resp.Diagnostics.HasError() {
+		return
+	} else if !found {
+		resp.Diagnostics.AddError("Failed to read Airflow backfill after create", fmt.Sprintf("Backfill %q not found immediately after creation", id))
+		return
+	}
+
+	if plan.WaitForCompletion.ValueBool() && plan.FailedRuns.ValueInt64() > 0 {
+		resp.Diagnostics.AddWarning(
+			"Airflow backfill has failed DAG runs",
+			fmt.Sprintf("Backfill %q of DAG %q completed with %d failed DAG run(s).", id, plan.DagID.ValueString(), plan.FailedRuns.ValueInt64()),
+		)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *backfillResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state backfillResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	found := r.readInto(ctx, &state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update pauses or unpauses the backfill; every other configurable attribute
+// uses RequiresReplace.
+func (r *backfillResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state backfillResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = state.ID
+	if !plan.Paused.Equal(state.Paused) && !r.setPaused(ctx, plan.ID.ValueString(), plan.Paused.ValueBool(), &resp.Diagnostics) {
+		return
+	}
+
+	if found := r.readInto(ctx, &plan, &resp.Diagnostics); resp.Diagnostics.HasError() {
+		return
+	} else if !found {
+		resp.Diagnostics.AddError("Failed to read Airflow backfill after update", fmt.Sprintf("Backfill %q not found", plan.ID.ValueString()))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete cancels the backfill unless it already completed.
+func (r *backfillResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state backfillResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.CompletedAt.ValueString() != "" {
+		return
+	}
+
+	id := state.ID.ValueString()
+	httpResp, err := r.config.Do(ctx, http.MethodPut, fmt.Sprintf("/backfills/%s/cancel", url.PathEscape(id)), nil, nil, nil)
+	if err != nil {
+		// 404: already gone; 409: completed since the last refresh.
+		if httpResp != nil && (httpResp.StatusCode == http.StatusNotFound || httpResp.StatusCode == http.StatusConflict) {
+			return
+		}
+		resp.Diagnostics.AddError("Failed to cancel Airflow backfill", clientError("cancel", id, httpResp, err))
+	}
+}
+
+func (r *backfillResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// readInto refreshes m from the backfill and its DAG runs, returning false if
+// the backfill no longer exists.
+func (r *backfillResource) readInto(ctx context.Context, m *backfillResourceModel, diags *diag.Diagnostics) bool {
+	id := m.ID.ValueString()
+	b, httpResp, err := r.getBackfill(ctx, id)
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+			return false
+		}
+		diags.AddError("Failed to read Airflow backfill", clientError("read", id, httpResp, err))
+		return false
+	}
+
+	m.DagID = types.StringValue(b.DagID)
+	// Airflow normalizes the dates, so keep the configured spelling of an
+	// unchanged instant.
+	if !sameInstant(m.FromDate.ValueString(), b.FromDate) {
+		m.FromDate = types.StringValue(b.FromDate)
+	}
+	if !sameInstant(m.ToDate.ValueString(), b.ToDate) {
+		m.ToDate = types.StringValue(b.ToDate)
+	}
+	m.ReprocessBehavior = types.StringValue(b.ReprocessBehavior)
+	m.MaxActiveRuns = types.Int64Value(b.MaxActiveRuns)
+	m.Paused = types.BoolValue(b.IsPaused)
+	m.CreatedAt = types.StringValue(b.CreatedAt)
+	m.CompletedAt = types.StringValue(derefString(b.CompletedAt))
+	if m.RunBackwards.IsNull() || m.RunBackwards.IsUnknown() {
+		// Not returned by the API; only reached on import.
+		m.RunBackwards = types.BoolValue(false)
+	}
+
+	if len(b.DagRunConf) > 0 || !m.DagRunConf.IsNull() {
+		confMap := make(map[string]string, len(b.DagRunConf))
+		for k, v := range b.DagRunConf {
+			confMap[k] = fmt.Sprintf("%v", v)
+		}
+		confValue, d := types.MapValueFrom(ctx, types.StringType, confMap)
+		diags.Append(d...)
+		m.DagRunConf = confValue
+	}
+
+	counts, httpResp, err := r.countRuns(ctx, b)
+	if err != nil {
+		diags.AddError("Failed to list Airflow backfill DAG runs", clientError("list", id, httpResp, err))
+		return false
+	}
+	m.TotalRuns = types.Int64Value(counts["total"])
+	m.QueuedRuns = types.Int64Value(counts["queued"])
+	m.RunningRuns = types.Int64Value(counts["running"])
+	m.SuccessRuns = types.Int64Value(counts["success"])
+	m.FailedRuns = types.Int64Value(counts["failed"])
+
+	return true
+}
+
+func (r *backfillResource) getBackfill(ctx context.Context, id string) (*backfill, *http.Response, error) {
+	var b backfill
+	httpResp, err := r.config.Do(ctx, http.MethodGet, fmt.Sprintf("/backfills/%s", url.PathEscape(id)), nil, nil, &b)
+	if err != nil {
+		return nil, httpResp, err
+	}
+	return &b, httpResp, nil
+}
+
+// countRuns counts the backfill DAG runs of b by state, plus their "total".
+// The API does not filter DAG runs by backfill, so this counts the backfill
+// runs of the DAG whose logical date falls in the backfill range.
+func (r *backfillResource) countRuns(ctx context.Context, b *backfill) (map[string]int64, *http.Response, error) {
+	query := url.Values{}
+	query.Set("run_type", "backfill")
+	query.Set("logical_date_gte", b.FromDate)
+	query.Set("logical_date_lte", b.ToDate)
+
+	type runState struct {
+		State string `json:"state"`
+	}
+	runs, httpResp, err := listPages[runState](ctx, r.config, fmt.Sprintf("/dags/%s/dagRuns", url.PathEscape(b.DagID)), query, "dag_runs", nil, 0)
+	if err != nil {
+		return nil, httpResp, err
+	}
+
+	counts := map[string]int64{}
+	for _, run := range runs {
+		counts["total"]++
+		counts[run.State]++
+	}
+	return counts, httpResp, nil
+}
+
+func (r *backfillResource) setPaused(ctx context.Context, id string, paused bool, diags *diag.Diagnostics) bool {
+	action := "unpause"
+	if paused {
+		action = "pause"
+	}
+
+	httpResp, err := r.config.Do(ctx, http.MethodPut, fmt.Sprintf("/backfills/%s/%s", url.PathEscape(id), action), nil, nil, nil)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Failed to %s Airflow backfill", action), clientError(action, id, httpResp, err))
+		return false
+	}
+	return true
+}
+
+// waitForCompletion polls the backfill until it completes, in the same way as
+// dagRunResource.waitForRun.
+func (r *backfillResource) waitForCompletion(ctx context.Context, id string, timeout time.Duration, diags *diag.Diagnostics) bool {
+	deadline := time.Now().Add(timeout)
+	for {
+		b, httpResp, err := r.getBackfill(ctx, id)
+		if err != nil {
+			diags.AddError("Failed to poll Airflow backfill", clientError("read", id, httpResp, err))
+			return false
+		}
+		if derefString(b.CompletedAt) != "" {
+			return true
+		}
+
+		if time.Now().After(deadline) {
+			diags.AddError("Timed out waiting for backfill", fmt.Sprintf("Backfill %q did not complete within %s", id, timeout))
+			return false
+		}
+
+		select {
+		case <-ctx.Done():
+			diags.AddError("Cancelled waiting for backfill", ctx.Err().Error())
+			return false
+		case <-time.After(5 * time.Second):
+		}
+	}
+}
+
+// sameInstant reports whether the RFC 3339 timestamps a and b denote the same
+// instant.
+func sameInstant(a, b string) bool {
+	ta, errA := time.Parse(time.RFC3339, a)
+	tb, errB := time.Parse(time.RFC3339, b)
+	if errA != nil || errB != nil {
+		return a == b
+	}
+	return ta.Equal(tb)
+}
+
+// rfc3339Validator validates that a string is an RFC 3339 timestamp.
+type rfc3339Validator struct{}
+
+func (v rfc3339Validator) Description(_ context.Context) string {
+	return "value must be an RFC 3339 timestamp"
+}
+
+func (v rfc3339Validator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v rfc3339Validator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := time.Parse(time.RFC3339, req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid timestamp",
+			fmt.Sprintf("%q is not an RFC 3339 timestamp (e.g. 2024-01-01T00:00:00Z): %s", req.ConfigValue.ValueString(), err),
+		)
+	}
+}
@@ -0,0 +1,90 @@
+package fwprovider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccAirflowBackfill_basic(t *testing.T) {
+	if os.Getenv("SKIP_AIRFLOW_DAG_TESTS") == "true" {
+		t.Skip("Skipping Airflow DAG tests")
+	}
+	if os.Getenv("AIRFLOW_API_BASE_PATH") == "" {
+		t.Skip("Backfills require Airflow 3 (API v2)")
+	}
+
+	resourceName := "airflow_backfill.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckAirflowBackfillCancelled,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAirflowBackfillConfig(true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "dag_id", dagId),
+					resource.TestCheckResourceAttr(resourceName, "from_date", "2024-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr(resourceName, "reprocess_behavior", "none"),
+					resource.TestCheckResourceAttr(resourceName, "max_active_runs", "1"),
+					resource.TestCheckResourceAttr(resourceName, "paused", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+					resource.TestCheckResourceAttrSet(resourceName, "total_runs"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"from_date", "to_date", "total_runs", "queued_runs", "running_runs", "success_runs", "failed_runs"},
+			},
+			{
+				Config: testAccAirflowBackfillConfig(false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "paused", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAirflowBackfillCancelled(s *terraform.State) error {
+	cfg, err := testAccProviderConfig()
+	if err != nil {
+		return err
+	}
+
+	r := &backfillResource{config: cfg}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "airflow_backfill" {
+			continue
+		}
+
+		b, _, err := r.getBackfill(context.Background(), rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("failed to read Airflow backfill %q: %w", rs.Primary.ID, err)
+		}
+		if derefString(b.CompletedAt) == "" {
+			return fmt.Errorf("Airflow backfill %q still running", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAirflowBackfillConfig(paused bool) string {
+	return fmt.Sprintf(`
+resource "airflow_backfill" "test" {
+  dag_id          = %[1]q
+  from_date       = "2024-01-01T00:00:00Z"
+  to_date         = "2024-01-03T00:00:00Z"
+  max_active_runs = 1
+  paused          = %[2]t
+}
+`, dagId, paused)
+}
@@ -65,11 +65,17 @@ func (r *connectionListResource) List(ctx context.Context, req list.ListRequest,
 
 			if req.IncludeResource {
 				// password / password_wo / extra are not returned by the list
-				// endpoint (sensitive), so they are left null.
+				// endpoint (sensitive), so they and the uri rendered from them
+				// are left null.
 				m := connectionResourceModel{
 					ID:           types.StringValue(c.GetConnectionId()),
 					ConnectionID: types.StringValue(c.GetConnectionId()),
 					ConnType:     types.StringValue(c.GetConnType()),
+					// The schema defaults, which Set does not apply.
+					ExtraManagement:      types.StringValue(extraManagementExact),
+					ExtraOverwriteMasked: types.BoolValue(false),
+					TestOnApply:          types.StringValue(connectionTestOff),
+					AdoptExisting:        types.BoolValue(false),
 				}
 				setListOptionalString(&m.Description, c.GetDescription())
 				setListOptionalString(&m.Host, c.GetHost())
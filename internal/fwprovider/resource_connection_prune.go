@@ -0,0 +1,294 @@
+package fwprovider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/drfaust92/terraform-provider-airflow/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource               = &connectionPruneResource{}
+	_ resource.ResourceWithConfigure  = &connectionPruneResource{}
+	_ resource.ResourceWithModifyPlan = &connectionPruneResource{}
+)
+
+// connectionPruneSpec describes a resource that deletes the Airflow
+// connections selected by its filter attributes.
+type connectionPruneSpec struct {
+	// typeName is the resource type name without the provider prefix.
+	typeName    string
+	description string
+	// attributes are the filter attributes, next to the common id,
+	// unmanaged_connection_ids and deleted_connection_ids.
+	attributes    map[string]schema.Attribute
+	idDescription string
+	// unmanaged describes the connections to delete, e.g. "default
+	// connections that exist and are not kept".
+	unmanaged string
+	// id returns the resource ID for the filter attributes of src.
+	id func(ctx context.Context, src attributeGetter, diags *diag.Diagnostics) string
+	// filter reads the filter attributes of src. It returns the pattern to
+	// list connections with ("" for all) and the matcher of the connections
+	// to delete, or ok = false while an attribute is unknown.
+	filter func(ctx context.Context, src attributeGetter, diags *diag.Diagnostics) (pattern string, match func(connectionItem) bool, ok bool)
+}
+
+// connectionPruneResource deletes the connections matched by its spec's
+// filter. Read reports the ones it finds in unmanaged_connection_ids, which
+// is planned as empty so that they show as drift, and the plan lists them in
+// deleted_connection_ids. Apply only deletes the connections planned there,
+// never ones created after the plan.
+type connectionPruneResource struct {
+	config client.ProviderConfig
+	spec   *connectionPruneSpec
+}
+
+func (r *connectionPruneResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.spec.typeName
+}
+
+func (r *connectionPruneResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attrs := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: r.spec.idDescription,
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"unmanaged_connection_ids": schema.ListAttribute{
+			MarkdownDescription: fmt.Sprintf("The %s, sorted, as found on refresh. They are planned for deletion, so this is empty after apply.", r.spec.unmanaged),
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+		"deleted_connection_ids": schema.ListAttribute{
+			MarkdownDescription: "The connections deleted by this resource, sorted. The plan lists the ones it is about to delete, and apply deletes no others.",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+	}
+	for name, a := range r.spec.attributes {
+		attrs[name] = a
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: r.spec.description,
+		Attributes:          attrs,
+	}
+}
+
+func (r *connectionPruneResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	cfg, ok := req.ProviderData.(client.ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.config = cfg
+}
+
+// ModifyPlan plans the deletion of the unmanaged connections: the ones found
+// by the refresh on update, or those that exist now on create, as long as
+// they still match the planned filter. They are added to
+// deleted_connection_ids and unmanaged_connection_ids is planned as empty.
+// Both stay unknown while the filter is.
+func (r *connectionPruneResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.config.ApiClient == nil {
+		return
+	}
+
+	matching, _, ok := r.list(ctx, req.Plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() || !ok {
+		return
+	}
+
+	var deleted, targets []string
+	if req.State.Raw.IsNull() {
+		targets = matching
+	} else {
+		found := r.listValue(ctx, req.State, "unmanaged_connection_ids", &resp.Diagnostics)
+		deleted = r.listValue(ctx, req.State, "deleted_connection_ids", &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		isMatching := make(map[string]bool, len(matching))
+		for _, id := range matching {
+			isMatching[id] = true
+		}
+		for _, id := range found {
+			if isMatching[id] {
+				targets = append(targets, id)
+			}
+		}
+	}
+
+	all := make(map[string]bool, len(deleted)+len(targets))
+	for _, id := range append(deleted, targets...) {
+		all[id] = true
+	}
+	deletedValue, d := types.ListValueFrom(ctx, types.StringType, sortedKeys(all))
+	resp.Diagnostics.Append(d...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("deleted_connection_ids"), deletedValue)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("unmanaged_connection_ids"), types.ListValueMust(types.StringType, nil))...)
+}
+
+func (r *connectionPruneResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	resp.State.Raw = req.Plan.Raw
+	id := r.spec.id(ctx, req.Plan, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	targets := r.listValue(ctx, req.Plan, "deleted_connection_ids", &resp.Diagnostics)
+	r.apply(ctx, req.Plan, nil, targets, &resp.State, &resp.Diagnostics)
+}
+
+func (r *connectionPruneResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	matching, _, _ := r.list(ctx, req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	unmanagedValue, d := types.ListValueFrom(ctx, types.StringType, matching)
+	resp.Diagnostics.Append(d...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("unmanaged_connection_ids"), unmanagedValue)...)
+}
+
+func (r *connectionPruneResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	deleted := r.listValue(ctx, req.State, "deleted_connection_ids", &resp.Diagnostics)
+	targets := r.listValue(ctx, req.State, "unmanaged_connection_ids", &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.State.Raw = req.Plan.Raw
+	r.apply(ctx, req.Plan, deleted, targets, &resp.State, &resp.Diagnostics)
+}
+
+// Delete only removes the resource from the state: the connections it
+// deleted are not recreated, and the ones it left are not touched.
+func (r *connectionPruneResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+// apply deletes the targets that still match the filter of plan: the
+// connections planned for deletion on create, or the ones found by the
+// refresh on update. deleted_connection_ids becomes the previously deleted
+// connections plus the targets deleted now or already gone. Whether a target
+// was deleted before does not matter, so a connection that was recreated is
+// deleted again. With an unknown plan nothing is deleted and the matching
+// connections are reported as unmanaged.
+func (r *connectionPruneResource) apply(ctx context.Context, plan tfsdk.Plan, deleted, targets []string, state *tfsdk.State, diags *diag.Diagnostics) {
+	var planned types.List
+	diags.Append(plan.GetAttribute(ctx, path.Root("deleted_connection_ids"), &planned)...)
+	matching, listed, _ := r.list(ctx, plan, diags)
+	if diags.HasError() {
+		return
+	}
+
+	all := make(map[string]bool, len(deleted))
+	for _, id := range deleted {
+		all[id] = true
+	}
+
+	unmanaged := types.ListValueMust(types.StringType, nil)
+	if planned.IsUnknown() {
+		var d diag.Diagnostics
+		unmanaged, d = types.ListValueFrom(ctx, types.StringType, matching)
+		diags.Append(d...)
+	} else {
+		var plannedIDs []string
+		diags.Append(planned.ElementsAs(ctx, &plannedIDs, false)...)
+		isPlanned := make(map[string]bool, len(plannedIDs))
+		for _, id := range plannedIDs {
+			isPlanned[id] = true
+		}
+		isMatching := make(map[string]bool, len(matching))
+		for _, id := range matching {
+			isMatching[id] = true
+		}
+
+		var toDelete []string
+		for _, id := range targets {
+			switch {
+			case isMatching[id]:
+				toDelete = append(toDelete, id)
+			case !listed[id]:
+				// Deleted since the plan.
+				all[id] = true
+			case isPlanned[id] && !all[id]:
+				diags.AddError("Airflow connection changed since the plan",
+					fmt.Sprintf("Connection %q was planned for deletion but no longer matches, so it was not deleted. Run terraform apply again to plan with its current state.", id))
+			}
+		}
+		for _, id := range deleteConnections(r.config, toDelete, diags) {
+			all[id] = true
+		}
+	}
+
+	deletedValue, d := types.ListValueFrom(ctx, types.StringType, sortedKeys(all))
+	diags.Append(d...)
+	diags.Append(state.SetAttribute(ctx, path.Root("deleted_connection_ids"), deletedValue)...)
+	diags.Append(state.SetAttribute(ctx, path.Root("unmanaged_connection_ids"), unmanaged)...)
+}
+
+// list lists the connections and returns the IDs of those matched by the
+// filter attributes of src, in order, and the set of all IDs listed. ok is
+// false, and nothing is listed, while the filter is unknown.
+func (r *connectionPruneResource) list(ctx context.Context, src attributeGetter, diags *diag.Diagnostics) (matching []string, listed map[string]bool, ok bool) {
+	pattern, match, ok := r.spec.filter(ctx, src, diags)
+	if diags.HasError() || !ok {
+		return nil, nil, false
+	}
+
+	conns, httpResp, err := listConnections(ctx, r.config, pattern)
+	if err != nil {
+		diags.AddError("Failed to list Airflow connections", clientError("list", r.spec.id(ctx, src, diags), httpResp, err))
+		return nil, nil, false
+	}
+
+	listed = make(map[string]bool, len(conns))
+	for _, c := range conns {
+		listed[c.ConnectionID] = true
+	}
+	return matchingConnectionIDs(conns, match), listed, true
+}
+
+// matchingConnectionIDs returns the IDs of conns, in order, that match.
+func matchingConnectionIDs(conns []connectionItem, match func(connectionItem) bool) []string {
+	ids := []string{}
+	for _, c := range conns {
+		if match(c) {
+			ids = append(ids, c.ConnectionID)
+		}
+	}
+	return ids
+}
+
+// listValue returns the elements of the string list attribute name of src.
+func (r *connectionPruneResource) listValue(ctx context.Context, src attributeGetter, name string, diags *diag.Diagnostics) []string {
+	var l types.List
+	diags.Append(src.GetAttribute(ctx, path.Root(name), &l)...)
+	var elems []string
+	if !l.IsNull() && !l.IsUnknown() {
+		diags.Append(l.ElementsAs(ctx, &elems, false)...)
+	}
+	return elems
+}
@@ -0,0 +1,560 @@
+package fwprovider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &typedConnectionResource{}
+	_ resource.ResourceWithConfigure   = &typedConnectionResource{}
+	_ resource.ResourceWithImportState = &typedConnectionResource{}
+	_ resource.ResourceWithIdentity    = &typedConnectionResource{}
+	_ resource.ResourceWithModifyPlan  = &typedConnectionResource{}
+)
+
+// connectionExtraKind is the Terraform type of a typed connection extra field.
+type connectionExtraKind int
+
+const (
+	extraKindString connectionExtraKind = iota
+	extraKindBool
+	extraKindInt64
+	// extraKindJSON is a JSON-encoded string attribute for extra fields holding
+	// nested objects, e.g. the AWS config_kwargs.
+	extraKindJSON
+)
+
+// connectionExtraField describes an extra key exposed as a top-level attribute
+// of a typed connection resource. The attribute is named after the key.
+type connectionExtraField struct {
+	key         string
+	kind        connectionExtraKind
+	description string
+	sensitive   bool
+	validators  []validator.String
+}
+
+// connectionTypeSpec describes a typed connection resource: a connection type
+// with the standard fields it uses and its extra fields.
+type connectionTypeSpec struct {
+	// typeName is the resource type name without the provider prefix.
+	typeName    string
+	connType    string
+	description string
+	// standard maps the standard connection fields the type uses (host, login,
+	// schema, port and password) to their descriptions.
+	standard map[string]string
+	extra    []connectionExtraField
+}
+
+// typedConnectionResource manages a connection of a single connection type
+// through typed attributes. It converts them to and from the model of
+// connectionResource and delegates to it, so both send the same connection
+// and share its read, masking and diff handling. Only the extra keys of the
+// type's fields are managed (extra_management = "merge"); other keys are left
+// untouched.
+type typedConnectionResource struct {
+	connectionResource
+	spec *connectionTypeSpec
+}
+
+func (r *typedConnectionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.spec.typeName
+}
+
+func (r *typedConnectionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attrs := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "The connection ID.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"connection_id": schema.StringAttribute{
+			MarkdownDescription: "The connection ID.",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"description": schema.StringAttribute{
+			MarkdownDescription: "The description of the connection.",
+			Optional:            true,
+		},
+		"test_on_apply": schema.StringAttribute{
+			MarkdownDescription: "Test the connection with Airflow's connection test after it is created or updated: `off`, `warn` or `error`. See `airflow_connection`. Defaults to `off`.",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString(connectionTestOff),
+			Validators: []validator.String{
+				stringvalidator.OneOf(connectionTestOff, connectionTestWarn, connectionTestError),
+			},
+		},
+		"extra_overwrite_masked": schema.BoolAttribute{
+			MarkdownDescription: "Allow an update that writes back extra keys not managed by this resource that Airflow returns masked as `***`, replacing their values with the mask. Without it such an update fails before anything is sent. See `airflow_connection`. Defaults to `false`.",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+		"adopt_existing": schema.BoolAttribute{
+			MarkdownDescription: "Take over the connection if one with the same ID already exists when the resource is created, e.g. a default connection created by `airflow db migrate`, instead of failing. See `airflow_connection`. **An adopted default connection must also be listed in `keep` of `airflow_default_connections`**, which otherwise deletes it on every apply. Defaults to `false`.",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+		"last_test_status": schema.StringAttribute{
+			MarkdownDescription: "The outcome of the last connection test run by `test_on_apply`: `success`, `failed` or `disabled`.",
+			Computed:            true,
+		},
+		"last_test_message": schema.StringAttribute{
+			MarkdownDescription: "The message of the last connection test run by `test_on_apply`.",
+			Computed:            true,
+		},
+		"team_name": schema.StringAttribute{
+			MarkdownDescription: "Team name for Airflow 3 multi-team deployments. Requires multi-team mode enabled and the team to exist; ignored on Airflow 2.",
+			Optional:            true,
+		},
+	}
+
+	for name, description := range r.spec.standard {
+		switch name {
+		case "port":
+			attrs[name] = schema.Int64Attribute{
+				MarkdownDescription: description,
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 65535),
+				},
+			}
+		case "password":
+			attrs["password"] = schema.StringAttribute{
+				MarkdownDescription: description,
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("password_wo")),
+				},
+			}
+			attrs["password_wo"] = schema.StringAttribute{
+				MarkdownDescription: description + " This field is write-only and is never stored in state. Requires Terraform 1.11 or later.",
+				Optional:            true,
+				WriteOnly:           true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("password")),
+					stringvalidator.AlsoRequires(path.MatchRoot("password_wo_version")),
+				},
+			}
+			attrs["password_wo_version"] = schema.StringAttribute{
+				MarkdownDescription: "Triggers update of `password_wo` write-only. For more info see [updating write-only attributes](https://developer.hashicorp.com/terraform/language/manage-sensitive-data/write-only).",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("password_wo")),
+				},
+			}
+		default:
+			attrs[name] = schema.StringAttribute{
+				MarkdownDescription: description,
+				Optional:            true,
+			}
+		}
+	}
+
+	for _, f := range r.spec.extra {
+		description := f.description + fmt.Sprintf(" Stored as the `%s` key of the extra.", f.key)
+		if f.sensitive {
+			description += " Never refreshed from Airflow, which masks it."
+		}
+		switch f.kind {
+		case extraKindBool:
+			attrs[f.key] = schema.BoolAttribute{MarkdownDescription: description, Optional: true}
+		case extraKindInt64:
+			attrs[f.key] = schema.Int64Attribute{MarkdownDescription: description, Optional: true}
+		case extraKindJSON:
+			attrs[f.key] = schema.StringAttribute{
+				MarkdownDescription: description + " A JSON-encoded object, e.g. from `jsonencode`.",
+				Optional:            true,
+				Sensitive:           f.sensitive,
+				PlanModifiers: []planmodifier.String{
+					suppressEquivalentJSON{},
+				},
+				Validators: append([]validator.String{jsonObjectValidator{}}, f.validators...),
+			}
+		default:
+			attrs[f.key] = schema.StringAttribute{
+				MarkdownDescription: description,
+				Optional:            true,
+				Sensitive:           f.sensitive,
+				Validators:          f.validators,
+			}
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: r.spec.description + fmt.Sprintf(" The connection has type `%s`. Only the extra keys of this resource's attributes are managed; other keys in the extra are left untouched.", r.spec.connType),
+		Attributes:          attrs,
+	}
+}
+
+func (r *typedConnectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	plan := r.toConnectionModel(ctx, req.Plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !r.create(ctx, plan, req.Config, &resp.Diagnostics) {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, connectionIdentityModel{ID: plan.ID})...)
+	r.fromConnectionModel(ctx, plan, req.Plan, &resp.State, &resp.Diagnostics)
+}
+
+func (r *typedConnectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	state := r.toConnectionModel(ctx, req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.ConnectionID.IsNull() {
+		// Imported: read every extra key of the type, not only configured ones.
+		state.ExtraObject = r.allExtraKeys(ctx, &resp.Diagnostics)
+	}
+
+	found := r.readInto(ctx, state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, connectionIdentityModel{ID: state.ID})...)
+	r.fromConnectionModel(ctx, state, req.State, &resp.State, &resp.Diagnostics)
+}
+
+func (r *typedConnectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	plan := r.toConnectionModel(ctx, req.Plan, &resp.Diagnostics)
+	state := r.toConnectionModel(ctx, req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if found := r.update(ctx, plan, state, req.Config, &resp.Diagnostics); !found {
+		if !resp.Diagnostics.HasError() {
+			resp.State.RemoveResource(ctx)
+		}
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, connectionIdentityModel{ID: plan.ID})...)
+	r.fromConnectionModel(ctx, plan, req.Plan, &resp.State, &resp.Diagnostics)
+}
+
+func (r *typedConnectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var id types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &id)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.config.ApiClient.ConnectionApi.DeleteConnection(r.config.AuthContext, id.ValueString()).Execute()
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+			return
+		}
+		resp.Diagnostics.AddError("Failed to delete Airflow connection", clientError("delete", id.ValueString(), httpResp, err))
+	}
+}
+
+// ModifyPlan warns on create when the provider package of the connection
+// type is not installed on the webserver. Only API v2 lists connection types.
+func (r *typedConnectionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || !req.State.Raw.IsNull() || r.config.ApiClient == nil {
+		return
+	}
+
+	known, err := getConnectionTypes(ctx, r.config)
+	if err != nil {
+		log.Printf("[DEBUG] Skipping connection type validation: %s", err)
+		return
+	}
+	if !known.exact || known.names[r.spec.connType] {
+		return
+	}
+	resp.Diagnostics.AddWarning("Airflow connection type not installed",
+		fmt.Sprintf("Connection type %q is not provided by any provider package installed on the Airflow webserver. The connection can be created, but Airflow cannot use it until the provider package is installed.", r.spec.connType))
+}
+
+// attributeGetter is implemented by tfsdk.Plan, tfsdk.State and tfsdk.Config.
+type attributeGetter interface {
+	GetAttribute(ctx context.Context, p path.Path, target interface{}) diag.Diagnostics
+}
+
+// toConnectionModel converts the typed attributes of src into the model of
+// connectionResource, encoding the extra fields that are set as extra_object.
+func (r *typedConnectionResource) toConnectionModel(ctx context.Context, src attributeGetter, diags *diag.Diagnostics) *connectionResourceModel {
+	get := func(name string, target interface{}) {
+		diags.Append(src.GetAttribute(ctx, path.Root(name), target)...)
+	}
+
+	m := &connectionResourceModel{
+		ConnType:        types.StringValue(r.spec.connType),
+		ExtraManagement: types.StringValue(extraManagementMerge),
+	}
+	get("id", &m.ID)
+	get("connection_id", &m.ConnectionID)
+	get("description", &m.Description)
+	get("team_name", &m.TeamName)
+	get("test_on_apply", &m.TestOnApply)
+	get("adopt_existing", &m.AdoptExisting)
+	get("extra_overwrite_masked", &m.ExtraOverwriteMasked)
+	get("last_test_status", &m.LastTestStatus)
+	get("last_test_message", &m.LastTestMessage)
+	for name := range r.spec.standard {
+		switch name {
+		case "host":
+			get(name, &m.Host)
+		case "login":
+			get(name, &m.Login)
+		case "schema":
+			get(name, &m.Schema)
+		case "port":
+			get(name, &m.Port)
+		case "password":
+			get(name, &m.Password)
+			get("password_wo_version", &m.PasswordWOVersion)
+		}
+	}
+
+	var sensitiveKeys []attr.Value
+	extra := map[string]interface{}{}
+	for _, f := range r.spec.extra {
+		if f.sensitive {
+			sensitiveKeys = append(sensitiveKeys, types.StringValue(f.key))
+		}
+		switch f.kind {
+		case extraKindBool:
+			var v types.Bool
+			get(f.key, &v)
+			if !v.IsNull() && !v.IsUnknown() {
+				extra[f.key] = v.ValueBool()
+			}
+		case extraKindInt64:
+			var v types.Int64
+			get(f.key, &v)
+			if !v.IsNull() && !v.IsUnknown() {
+				extra[f.key] = v.ValueInt64()
+			}
+		case extraKindJSON:
+			var v types.String
+			get(f.key, &v)
+			if !v.IsNull() && !v.IsUnknown() {
+				var obj interface{}
+				if err := json.Unmarshal([]byte(v.ValueString()), &obj); err != nil {
+					diags.AddAttributeError(path.Root(f.key), "Invalid JSON", err.Error())
+					continue
+				}
+				extra[f.key] = obj
+			}
+		default:
+			var v types.String
+			get(f.key, &v)
+			if !v.IsNull() && !v.IsUnknown() {
+				extra[f.key] = v.ValueString()
+			}
+		}
+	}
+	m.ExtraSensitiveKeys = types.SetValueMust(types.StringType, sensitiveKeys)
+
+	if len(extra) > 0 {
+		b, err := json.Marshal(extra)
+		if err == nil {
+			m.ExtraObject, err = jsonToDynamic(ctx, b)
+		}
+		if err != nil {
+			diags.AddError("Failed to encode Airflow connection extra", err.Error())
+		}
+	}
+	return m
+}
+
+// allExtraKeys returns an extra_object with every extra key of the type set
+// to null, so readInto reads all of them back.
+func (r *typedConnectionResource) allExtraKeys(ctx context.Context, diags *diag.Diagnostics) types.Dynamic {
+	keys := make(map[string]interface{}, len(r.spec.extra))
+	for _, f := range r.spec.extra {
+		keys[f.key] = nil
+	}
+	b, _ := json.Marshal(keys)
+	v, err := jsonToDynamic(ctx, b)
+	if err != nil {
+		diags.AddError("Failed to encode Airflow connection extra", err.Error())
+	}
+	return v
+}
+
+// fromConnectionModel writes m back to the typed attributes of dst. Extra
+// fields that are absent from the extra, or hold masked values Airflow would
+// not reveal, are set to null. JSON fields keep their formatting from prior.
+func (r *typedConnectionResource) fromConnectionModel(ctx context.Context, m *connectionResourceModel, prior attributeGetter, dst *tfsdk.State, diags *diag.Diagnostics) {
+	set := func(name string, value interface{}) {
+		diags.Append(dst.SetAttribute(ctx, path.Root(name), value)...)
+	}
+
+	set("id", m.ID)
+	set("connection_id", m.ConnectionID)
+	set("description", m.Description)
+	set("team_name", m.TeamName)
+	set("test_on_apply", m.TestOnApply)
+	set("adopt_existing", m.AdoptExisting)
+	set("extra_overwrite_masked", m.ExtraOverwriteMasked)
+	set("last_test_status", m.LastTestStatus)
+	set("last_test_message", m.LastTestMessage)
+	for name := range r.spec.standard {
+		switch name {
+		case "host":
+			set(name, m.Host)
+		case "login":
+			set(name, m.Login)
+		case "schema":
+			set(name, m.Schema)
+		case "port":
+			set(name, m.Port)
+		case "password":
+			set(name, m.Password)
+			set("password_wo_version", m.PasswordWOVersion)
+		}
+	}
+
+	extra := map[string]interface{}{}
+	if !m.ExtraObject.IsNull() {
+		b, err := dynamicToJSON(m.ExtraObject)
+		if err != nil {
+			diags.AddError("Failed to decode Airflow connection extra", err.Error())
+			return
+		}
+		_ = json.Unmarshal(b, &extra)
+	}
+
+	for _, f := range r.spec.extra {
+		v, ok := extra[f.key]
+		if !ok || f.kind != extraKindJSON && containsMasked(v) {
+			v = nil
+		}
+		switch f.kind {
+		case extraKindBool:
+			set(f.key, extraBoolValue(v))
+		case extraKindInt64:
+			set(f.key, extraInt64Value(v))
+		case extraKindJSON:
+			var p types.String
+			diags.Append(prior.GetAttribute(ctx, path.Root(f.key), &p)...)
+			set(f.key, extraJSONValue(v, p))
+		default:
+			set(f.key, extraStringValue(v))
+		}
+	}
+}
+
+// extraStringValue converts an extra value to a string attribute value;
+// non-string values are JSON-encoded.
+func extraStringValue(v interface{}) types.String {
+	switch v := v.(type) {
+	case nil:
+		return types.StringNull()
+	case string:
+		return types.StringValue(v)
+	default:
+		b, _ := json.Marshal(v)
+		return types.StringValue(string(b))
+	}
+}
+
+// extraBoolValue converts an extra value to a bool attribute value, accepting
+// the strings Airflow's connection form stores ("true", "False", ...).
+func extraBoolValue(v interface{}) types.Bool {
+	switch v := v.(type) {
+	case bool:
+		return types.BoolValue(v)
+	case string:
+		if b, err := strconv.ParseBool(v); err == nil {
+			return types.BoolValue(b)
+		}
+	}
+	return types.BoolNull()
+}
+
+// extraInt64Value converts an extra value to an int64 attribute value,
+// accepting numeric strings.
+func extraInt64Value(v interface{}) types.Int64 {
+	switch v := v.(type) {
+	case float64:
+		if v == float64(int64(v)) {
+			return types.Int64Value(int64(v))
+		}
+	case string:
+		if i, err := strconv.ParseInt(v, 10, 64); err == nil {
+			return types.Int64Value(i)
+		}
+	}
+	return types.Int64Null()
+}
+
+// extraJSONValue JSON-encodes an extra value, keeping the prior attribute
+// value when it is semantically equal so formatting does not show as drift.
+func extraJSONValue(v interface{}, prior types.String) types.String {
+	if v == nil {
+		return types.StringNull()
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return types.StringNull()
+	}
+	if !prior.IsNull() && !prior.IsUnknown() && jsonSemanticEqual(prior.ValueString(), string(b)) {
+		return prior
+	}
+	return types.StringValue(string(b))
+}
+
+// jsonObjectValidator validates that a string is a JSON-encoded object.
+type jsonObjectValidator struct{}
+
+func (v jsonObjectValidator) Description(_ context.Context) string {
+	return "value must be a JSON-encoded object"
+}
+
+func (v jsonObjectValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v jsonObjectValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	var obj map[string]interface{}
+	if err := json.Unmarshal([]byte(req.ConfigValue.ValueString()), &obj); err != nil || obj == nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid JSON object", "The value must be a JSON-encoded object, e.g. from jsonencode.")
+	}
+}
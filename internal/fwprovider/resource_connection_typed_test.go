@@ -0,0 +1,166 @@
+package fwprovider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	resourcetest "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAirflowConnectionAWS_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "airflow_connection_aws.test"
+
+	resourcetest.Test(t, resourcetest.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckAirflowConnectionCheckDestroy,
+		Steps: []resourcetest.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "airflow_connection_aws" "test" {
+  connection_id     = %[1]q
+  region_name       = "eu-west-1"
+  role_arn          = "arn:aws:iam::123456789012:role/airflow"
+  aws_session_token = "session-token"
+  config_kwargs     = jsonencode({ retries = { max_attempts = 10 } })
+}
+`, rName),
+				Check: resourcetest.ComposeTestCheckFunc(
+					resourcetest.TestCheckResourceAttr(resourceName, "id", rName),
+					resourcetest.TestCheckResourceAttr(resourceName, "region_name", "eu-west-1"),
+					resourcetest.TestCheckResourceAttr(resourceName, "aws_session_token", "session-token"),
+					testAccCheckAirflowConnectionExtra(resourceName, `{"region_name":"eu-west-1","role_arn":"arn:aws:iam::123456789012:role/airflow","aws_session_token":"session-token","config_kwargs":{"retries":{"max_attempts":10}}}`),
+				),
+			},
+			{
+				// Keys without an attribute are left alone.
+				PreConfig: func() {
+					testAccSetAirflowConnectionExtra(t, rName, `{"region_name":"eu-west-1","role_arn":"arn:aws:iam::123456789012:role/airflow","aws_session_token":"session-token","config_kwargs":{"retries":{"max_attempts":10}},"custom":"x"}`)
+				},
+				Config: fmt.Sprintf(`
+resource "airflow_connection_aws" "test" {
+  connection_id     = %[1]q
+  region_name       = "us-east-1"
+  aws_session_token = "session-token"
+  config_kwargs     = jsonencode({ retries = { max_attempts = 10 } })
+}
+`, rName),
+				Check: resourcetest.ComposeTestCheckFunc(
+					resourcetest.TestCheckResourceAttr(resourceName, "region_name", "us-east-1"),
+					resourcetest.TestCheckNoResourceAttr(resourceName, "role_arn"),
+					testAccCheckAirflowConnectionExtra(resourceName, `{"region_name":"us-east-1","aws_session_token":"session-token","config_kwargs":{"retries":{"max_attempts":10}},"custom":"x"}`),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"aws_session_token"},
+			},
+		},
+	})
+}
+
+func TestAccAirflowConnectionPostgres_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "airflow_connection_postgres.test"
+
+	resourcetest.Test(t, resourcetest.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckAirflowConnectionCheckDestroy,
+		Steps: []resourcetest.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "airflow_connection_postgres" "test" {
+  connection_id = %[1]q
+  host          = "db.example.com"
+  port          = 5432
+  schema        = "warehouse"
+  login         = "airflow"
+  password      = "secret"
+  sslmode       = "require"
+  iam           = false
+}
+`, rName),
+				Check: resourcetest.ComposeTestCheckFunc(
+					resourcetest.TestCheckResourceAttr(resourceName, "host", "db.example.com"),
+					resourcetest.TestCheckResourceAttr(resourceName, "sslmode", "require"),
+					resourcetest.TestCheckResourceAttr(resourceName, "iam", "false"),
+					testAccCheckAirflowConnectionExtra(resourceName, `{"sslmode":"require","iam":false}`),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+		},
+	})
+}
+
+func TestTypedConnectionSchemas(t *testing.T) {
+	for _, newResource := range []func() resource.Resource{
+		newAWSConnectionResource,
+		newGoogleCloudConnectionResource,
+		newPostgresConnectionResource,
+		newSnowflakeConnectionResource,
+		newDatabricksConnectionResource,
+		newKubernetesConnectionResource,
+	} {
+		r := newResource().(*typedConnectionResource)
+		resp := &resource.SchemaResponse{}
+		r.Schema(context.Background(), resource.SchemaRequest{}, resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("%s: %v", r.spec.typeName, resp.Diagnostics)
+		}
+		if diags := resp.Schema.ValidateImplementation(context.Background()); diags.HasError() {
+			t.Errorf("%s: %v", r.spec.typeName, diags)
+		}
+
+		// Extra attributes must not shadow the common ones.
+		base := &typedConnectionResource{spec: &connectionTypeSpec{standard: r.spec.standard}}
+		baseResp := &resource.SchemaResponse{}
+		base.Schema(context.Background(), resource.SchemaRequest{}, baseResp)
+		for _, f := range r.spec.extra {
+			if _, ok := baseResp.Schema.Attributes[f.key]; ok {
+				t.Errorf("%s: extra key %q collides with another attribute", r.spec.typeName, f.key)
+			}
+		}
+	}
+}
+
+func TestExtraValueConversions(t *testing.T) {
+	if got := extraBoolValue("True"); !got.Equal(types.BoolValue(true)) {
+		t.Errorf(`extraBoolValue("True") = %s`, got)
+	}
+	if got := extraBoolValue("maybe"); !got.IsNull() {
+		t.Errorf(`extraBoolValue("maybe") = %s, want null`, got)
+	}
+	if got := extraInt64Value(float64(3)); !got.Equal(types.Int64Value(3)) {
+		t.Errorf("extraInt64Value(3) = %s", got)
+	}
+	if got := extraInt64Value("5"); !got.Equal(types.Int64Value(5)) {
+		t.Errorf(`extraInt64Value("5") = %s`, got)
+	}
+	if got := extraInt64Value(1.5); !got.IsNull() {
+		t.Errorf("extraInt64Value(1.5) = %s, want null", got)
+	}
+	if got := extraStringValue(float64(2)); !got.Equal(types.StringValue("2")) {
+		t.Errorf("extraStringValue(2) = %s", got)
+	}
+
+	prior := types.StringValue(`{ "b": 1, "a": 2 }`)
+	if got := extraJSONValue(map[string]interface{}{"a": float64(2), "b": float64(1)}, prior); !got.Equal(prior) {
+		t.Errorf("extraJSONValue kept %s, want prior %s", got, prior)
+	}
+	if got := extraJSONValue(map[string]interface{}{"a": float64(3)}, prior); !got.Equal(types.StringValue(`{"a":3}`)) {
+		t.Errorf("extraJSONValue = %s", got)
+	}
+}
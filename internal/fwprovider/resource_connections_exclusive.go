@@ -0,0 +1,94 @@
+package fwprovider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func newConnectionsExclusiveResource() resource.Resource {
+	return &connectionPruneResource{spec: connectionsExclusiveSpec}
+}
+
+// connectionsExclusiveSpec deletes the connections whose ID matches a prefix
+// or pattern and that are not listed in connection_ids.
+var connectionsExclusiveSpec = &connectionPruneSpec{
+	typeName:    "connections_exclusive",
+	description: "Makes Terraform the only source of the Airflow connections whose ID matches a prefix or pattern: matching connections not listed in `connection_ids`, e.g. ones created by hand in the UI, are reported as drift and deleted on apply. Deleting this resource only removes it from the state; deleted connections are not recreated.",
+	attributes: map[string]schema.Attribute{
+		"connection_id_prefix": schema.StringAttribute{
+			MarkdownDescription: "Manage the connections whose ID starts with this prefix, e.g. `team_a_`. Exactly one of `connection_id_prefix` or `connection_id_pattern` must be set.",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+				stringvalidator.ExactlyOneOf(path.MatchRoot("connection_id_pattern")),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"connection_id_pattern": schema.StringAttribute{
+			MarkdownDescription: "Manage the connections whose ID contains this pattern. SQL `LIKE` wildcards (`%`, `_`) are supported.",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"connection_ids": schema.SetAttribute{
+			MarkdownDescription: "The IDs of the matching connections managed by Terraform, e.g. `[for c in airflow_connection.team_a : c.connection_id]`. Every other matching connection is deleted.",
+			Required:            true,
+			ElementType:         types.StringType,
+		},
+	},
+	idDescription: "The `connection_id_prefix` or `connection_id_pattern`.",
+	unmanaged:     "matching connections that are not in `connection_ids`",
+	id: func(ctx context.Context, src attributeGetter, diags *diag.Diagnostics) string {
+		var prefix, pattern types.String
+		diags.Append(src.GetAttribute(ctx, path.Root("connection_id_prefix"), &prefix)...)
+		diags.Append(src.GetAttribute(ctx, path.Root("connection_id_pattern"), &pattern)...)
+		return prefix.ValueString() + pattern.ValueString()
+	},
+	filter: func(ctx context.Context, src attributeGetter, diags *diag.Diagnostics) (string, func(connectionItem) bool, bool) {
+		var prefix, pattern types.String
+		var managed types.Set
+		diags.Append(src.GetAttribute(ctx, path.Root("connection_id_prefix"), &prefix)...)
+		diags.Append(src.GetAttribute(ctx, path.Root("connection_id_pattern"), &pattern)...)
+		diags.Append(src.GetAttribute(ctx, path.Root("connection_ids"), &managed)...)
+		if diags.HasError() || prefix.IsUnknown() || pattern.IsUnknown() || managed.IsUnknown() {
+			return "", nil, false
+		}
+
+		var ids []string
+		diags.Append(managed.ElementsAs(ctx, &ids, false)...)
+		if prefix.ValueString() != "" {
+			// A prefix is a pattern as well; the match is narrowed by the
+			// matcher.
+			return prefix.ValueString(), unmanagedConnectionMatcher(prefix.ValueString(), ids), true
+		}
+		return pattern.ValueString(), unmanagedConnectionMatcher("", ids), true
+	},
+}
+
+// unmanagedConnectionMatcher matches the connections whose ID starts with
+// prefix and is not in managed.
+func unmanagedConnectionMatcher(prefix string, managed []string) func(connectionItem) bool {
+	isManaged := make(map[string]bool, len(managed))
+	for _, id := range managed {
+		isManaged[id] = true
+	}
+	return func(c connectionItem) bool {
+		return strings.HasPrefix(c.ConnectionID, prefix) && !isManaged[c.ConnectionID]
+	}
+}
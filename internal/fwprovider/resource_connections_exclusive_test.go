@@ -0,0 +1,251 @@
+package fwprovider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/drfaust92/terraform-provider-airflow/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAirflowConnectionsExclusive_basic(t *testing.T) {
+	prefix := acctest.RandomWithPrefix("tf-acc-test") + "_"
+	resourceName := "airflow_connections_exclusive.test"
+	config := fmt.Sprintf(`
+resource "airflow_connection" "managed" {
+  connection_id = "%[1]smanaged"
+  conn_type     = "http"
+}
+
+resource "airflow_connections_exclusive" "test" {
+  connection_id_prefix = %[1]q
+  connection_ids       = [airflow_connection.managed.connection_id]
+}
+`, prefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckAirflowConnectionCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() { testAccCreateAirflowConnection(t, prefix+"manual", "http", "") },
+				Config:    config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", prefix),
+					resource.TestCheckResourceAttr(resourceName, "unmanaged_connection_ids.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "deleted_connection_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "deleted_connection_ids.0", prefix+"manual"),
+					testAccCheckAirflowConnectionExists(prefix+"managed", true),
+					testAccCheckAirflowConnectionExists(prefix+"manual", false),
+				),
+			},
+			{
+				// A connection created by hand under the prefix is drift.
+				PreConfig:          func() { testAccCreateAirflowConnection(t, prefix+"ui", "http", "") },
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "unmanaged_connection_ids.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "deleted_connection_ids.#", "2"),
+					testAccCheckAirflowConnectionExists(prefix+"ui", false),
+				),
+			},
+		},
+	})
+}
+
+func TestUnmanagedConnectionMatcher(t *testing.T) {
+	// The prefix is also sent as a LIKE pattern, which matches more.
+	conns := []connectionItem{
+		{ConnectionID: "other_team_a_x"},
+		{ConnectionID: "team_a_managed"},
+		{ConnectionID: "team_a_ui"},
+		{ConnectionID: "teamXa_y"},
+	}
+
+	got := matchingConnectionIDs(conns, unmanagedConnectionMatcher("team_a_", []string{"team_a_managed"}))
+	if want := []string{"team_a_ui"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("unmanagedConnectionMatcher() matched %v, want %v", got, want)
+	}
+	got = matchingConnectionIDs(conns, unmanagedConnectionMatcher("", []string{"team_a_managed"}))
+	if want := []string{"other_team_a_x", "team_a_ui", "teamXa_y"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("unmanagedConnectionMatcher() without prefix matched %v, want %v", got, want)
+	}
+}
+
+// testConnectionsServer serves the connection list and delete endpoints
+// from conns, which the caller may change between requests while holding mu.
+func testConnectionsServer(t *testing.T, mu *sync.Mutex, conns map[string]bool) client.ProviderConfig {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/api/v1/connections":
+			items := []connectionItem{}
+			for _, id := range sortedKeys(conns) {
+				items = append(items, connectionItem{ConnectionID: id, ConnType: "http"})
+			}
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"connections": items, "total_entries": len(items)})
+		case req.Method == http.MethodDelete && strings.HasPrefix(req.URL.Path, "/api/v1/connections/"):
+			delete(conns, strings.TrimPrefix(req.URL.Path, "/api/v1/connections/"))
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			http.NotFound(w, req)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	cfg, err := client.NewProviderConfig(srv.URL, "", "", "", false, "/api/v1", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cfg
+}
+
+// testConnectionsExclusivePlan returns the plan to create an
+// airflow_connections_exclusive for the prefix team_a_ that manages
+// team_a_managed, after ModifyPlan.
+func testConnectionsExclusivePlan(ctx context.Context, t *testing.T, r *connectionPruneResource) tfsdk.Plan {
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+	plan := tfsdk.Plan{Schema: schemaResp.Schema}
+	managed, _ := types.SetValueFrom(ctx, types.StringType, []string{"team_a_managed"})
+	if diags := plan.Set(ctx, &struct {
+		ID                     types.String `tfsdk:"id"`
+		ConnectionIDPrefix     types.String `tfsdk:"connection_id_prefix"`
+		ConnectionIDPattern    types.String `tfsdk:"connection_id_pattern"`
+		ConnectionIDs          types.Set    `tfsdk:"connection_ids"`
+		UnmanagedConnectionIDs types.List   `tfsdk:"unmanaged_connection_ids"`
+		DeletedConnectionIDs   types.List   `tfsdk:"deleted_connection_ids"`
+	}{
+		ID:                     types.StringUnknown(),
+		ConnectionIDPrefix:     types.StringValue("team_a_"),
+		ConnectionIDPattern:    types.StringNull(),
+		ConnectionIDs:          managed,
+		UnmanagedConnectionIDs: types.ListUnknown(types.StringType),
+		DeletedConnectionIDs:   types.ListUnknown(types.StringType),
+	}); diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	planResp := &fwresource.ModifyPlanResponse{Plan: plan}
+	r.ModifyPlan(ctx, fwresource.ModifyPlanRequest{Plan: plan, State: tfsdk.State{Schema: schemaResp.Schema}}, planResp)
+	if planResp.Diagnostics.HasError() {
+		t.Fatal(planResp.Diagnostics)
+	}
+	return planResp.Plan
+}
+
+// TestConnectionsExclusiveDeletesOnlyPlanned verifies that apply deletes the
+// connections listed in the plan, and not one created after the plan.
+func TestConnectionsExclusiveDeletesOnlyPlanned(t *testing.T) {
+	var mu sync.Mutex
+	conns := map[string]bool{"team_a_managed": true, "team_a_manual": true}
+	r := &connectionPruneResource{config: testConnectionsServer(t, &mu, conns), spec: connectionsExclusiveSpec}
+	ctx := context.Background()
+
+	plan := testConnectionsExclusivePlan(ctx, t, r)
+	var planned []string
+	plan.GetAttribute(ctx, path.Root("deleted_connection_ids"), &planned)
+	if want := []string{"team_a_manual"}; !reflect.DeepEqual(planned, want) {
+		t.Fatalf("planned deleted_connection_ids = %v, want %v", planned, want)
+	}
+
+	// Created after the plan, so not shown in it.
+	mu.Lock()
+	conns["team_a_late"] = true
+	mu.Unlock()
+	createResp := &fwresource.CreateResponse{State: tfsdk.State{Schema: plan.Schema}}
+	r.Create(ctx, fwresource.CreateRequest{Plan: plan}, createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatal(createResp.Diagnostics)
+	}
+	if want := map[string]bool{"team_a_managed": true, "team_a_late": true}; !reflect.DeepEqual(conns, want) {
+		t.Errorf("after apply connections = %v, want %v", sortedKeys(conns), sortedKeys(want))
+	}
+	var deleted []string
+	createResp.State.GetAttribute(ctx, path.Root("deleted_connection_ids"), &deleted)
+	if !reflect.DeepEqual(deleted, planned) {
+		t.Errorf("deleted_connection_ids = %v, want %v", deleted, planned)
+	}
+
+	// The refresh reports it, so the next plan deletes it.
+	readResp := &fwresource.ReadResponse{State: createResp.State}
+	r.Read(ctx, fwresource.ReadRequest{State: createResp.State}, readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatal(readResp.Diagnostics)
+	}
+	var unmanaged []string
+	readResp.State.GetAttribute(ctx, path.Root("unmanaged_connection_ids"), &unmanaged)
+	if want := []string{"team_a_late"}; !reflect.DeepEqual(unmanaged, want) {
+		t.Errorf("refreshed unmanaged_connection_ids = %v, want %v", unmanaged, want)
+	}
+}
+
+// TestConnectionsExclusiveDeletesRecreated verifies that a connection that
+// was deleted, then recreated, is deleted again on the next apply.
+func TestConnectionsExclusiveDeletesRecreated(t *testing.T) {
+	var mu sync.Mutex
+	conns := map[string]bool{"team_a_managed": true, "team_a_manual": true}
+	r := &connectionPruneResource{config: testConnectionsServer(t, &mu, conns), spec: connectionsExclusiveSpec}
+	ctx := context.Background()
+
+	plan := testConnectionsExclusivePlan(ctx, t, r)
+	createResp := &fwresource.CreateResponse{State: tfsdk.State{Schema: plan.Schema}}
+	r.Create(ctx, fwresource.CreateRequest{Plan: plan}, createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatal(createResp.Diagnostics)
+	}
+
+	mu.Lock()
+	conns["team_a_manual"] = true
+	mu.Unlock()
+	readResp := &fwresource.ReadResponse{State: createResp.State}
+	r.Read(ctx, fwresource.ReadRequest{State: createResp.State}, readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatal(readResp.Diagnostics)
+	}
+
+	state := readResp.State
+	planResp := &fwresource.ModifyPlanResponse{Plan: tfsdk.Plan{Schema: state.Schema, Raw: state.Raw}}
+	r.ModifyPlan(ctx, fwresource.ModifyPlanRequest{Plan: planResp.Plan, State: state}, planResp)
+	if planResp.Diagnostics.HasError() {
+		t.Fatal(planResp.Diagnostics)
+	}
+
+	updateResp := &fwresource.UpdateResponse{State: state}
+	r.Update(ctx, fwresource.UpdateRequest{Plan: planResp.Plan, State: state}, updateResp)
+	if updateResp.Diagnostics.HasError() {
+		t.Fatal(updateResp.Diagnostics)
+	}
+	if want := map[string]bool{"team_a_managed": true}; !reflect.DeepEqual(conns, want) {
+		t.Errorf("after the second apply connections = %v, want %v", sortedKeys(conns), sortedKeys(want))
+	}
+	var deleted, unmanaged []string
+	updateResp.State.GetAttribute(ctx, path.Root("deleted_connection_ids"), &deleted)
+	updateResp.State.GetAttribute(ctx, path.Root("unmanaged_connection_ids"), &unmanaged)
+	if want := []string{"team_a_manual"}; !reflect.DeepEqual(deleted, want) {
+		t.Errorf("deleted_connection_ids = %v, want %v", deleted, want)
+	}
+	if len(unmanaged) != 0 {
+		t.Errorf("unmanaged_connection_ids = %v, want none", unmanaged)
+	}
+}
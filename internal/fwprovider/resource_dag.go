@@ -32,16 +32,18 @@ type dagResource struct {
 }
 
 type dagResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	DagID       types.String `tfsdk:"dag_id"`
-	Description types.String `tfsdk:"description"`
-	DeleteDag   types.Bool   `tfsdk:"delete_dag"`
-	FileToken   types.String `tfsdk:"file_token"`
-	Fileloc     types.String `tfsdk:"fileloc"`
-	IsActive    types.Bool   `tfsdk:"is_active"`
-	IsPaused    types.Bool   `tfsdk:"is_paused"`
-	IsSubdag    types.Bool   `tfsdk:"is_subdag"`
-	RootDagID   types.String `tfsdk:"root_dag_id"`
+	ID                 types.String `tfsdk:"id"`
+	DagID              types.String `tfsdk:"dag_id"`
+	Description        types.String `tfsdk:"description"`
+	DeleteDag          types.Bool   `tfsdk:"delete_dag"`
+	FailOnImportErrors types.Bool   `tfsdk:"fail_on_import_errors"`
+	FileToken          types.String `tfsdk:"file_token"`
+	Fileloc            types.String `tfsdk:"fileloc"`
+	IsActive           types.Bool   `tfsdk:"is_active"`
+	IsPaused           types.Bool   `tfsdk:"is_paused"`
+	IsSubdag           types.Bool   `tfsdk:"is_subdag"`
+	RootDagID          types.String `tfsdk:"root_dag_id"`
+	dagDetailsModel
 }
 
 func (r *dagResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -76,6 +78,10 @@ func (r *dagResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *
 				Computed:            true,
 				Default:             booldefault.StaticBool(false),
 			},
+			"fail_on_import_errors": schema.BoolAttribute{
+				MarkdownDescription: "Whether import errors for the DAG's file fail create and update. Import errors are always reported as warnings when the DAG is read.",
+				Optional:            true,
+			},
 			"file_token": schema.StringAttribute{
 				MarkdownDescription: "The key containing the encrypted path to the file. Encryption and decryption take place only on the server. This prevents the client from reading a non-DAG file.",
 				Computed:            true,
@@ -100,6 +106,48 @@ func (r *dagResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *
 				MarkdownDescription: "If the DAG is a SubDAG then it is the top level DAG identifier. Otherwise, null.",
 				Computed:            true,
 			},
+			"tags": schema.ListAttribute{
+				MarkdownDescription: "The names of the DAG's tags.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"owners": schema.ListAttribute{
+				MarkdownDescription: "The owners of the DAG.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"schedule_interval": schema.StringAttribute{
+				MarkdownDescription: "The DAG schedule: the cron expression, the timetable summary on Airflow 3, or the JSON-encoded schedule interval for non-cron schedules on Airflow 2.",
+				Computed:            true,
+			},
+			"timetable_description": schema.StringAttribute{
+				MarkdownDescription: "A human-readable description of the DAG's timetable.",
+				Computed:            true,
+			},
+			"next_dagrun": schema.StringAttribute{
+				MarkdownDescription: "The logical date of the next DAG run, in RFC 3339 format.",
+				Computed:            true,
+			},
+			"max_active_runs": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of active DAG runs.",
+				Computed:            true,
+			},
+			"has_import_errors": schema.BoolAttribute{
+				MarkdownDescription: "Whether the DAG file has import errors.",
+				Computed:            true,
+			},
+			"last_parsed_time": schema.StringAttribute{
+				MarkdownDescription: "The last time the DAG file was parsed, in RFC 3339 format.",
+				Computed:            true,
+			},
+			"default_view": schema.StringAttribute{
+				MarkdownDescription: "The default UI view of the DAG. Not reported by Airflow 3.",
+				Computed:            true,
+			},
+			"params": schema.StringAttribute{
+				MarkdownDescription: "The JSON-encoded DAG params.",
+				Computed:            true,
+			},
 		},
 	}
 }
@@ -145,7 +193,7 @@ func (r *dagResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 		return
 	}
 
-	found := r.readInto(&state, &resp.Diagnostics)
+	found := r.readInto(ctx, &state, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -154,6 +202,10 @@ func (r *dagResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 		return
 	}
 
+	// Never fail a refresh on import errors, or a broken DAG file would also
+	// block destroying the resource.
+	reportImportErrors(ctx, r.config, state.dagDetailsModel, state.DagID.ValueString(), state.Fileloc.ValueString(), false, &resp.Diagnostics)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -199,30 +251,38 @@ func (r *dagResource) ImportState(ctx context.Context, req resource.ImportStateR
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
-// apply patches the DAG's is_paused flag and refreshes the model.
-func (r *dagResource) apply(_ context.Context, m *dagResourceModel, diags *diag.Diagnostics) {
+// apply patches the DAG's is_paused flag, refreshes the model and reports any
+// import errors for the DAG's file, also when the DAG is not found.
+func (r *dagResource) apply(ctx context.Context, m *dagResourceModel, diags *diag.Diagnostics) {
 	dagID := m.DagID.ValueString()
 
 	dag := *airflow.NewDAG()
 	dag.SetIsPaused(m.IsPaused.ValueBool())
 
 	_, httpResp, err := r.config.ApiClient.DAGApi.PatchDag(r.config.AuthContext, dagID).DAG(dag).Execute()
+	if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+		diags.AddError("Failed to update Airflow DAG", dagNotFoundDetail(ctx, r.config, dagID, m.Fileloc.ValueString()))
+		return
+	}
 	if err != nil {
 		diags.AddError("Failed to update Airflow DAG", clientError("update", dagID, httpResp, err))
 		return
 	}
 
-	if found := r.readInto(m, diags); diags.HasError() {
+	if found := r.readInto(ctx, m, diags); diags.HasError() {
 		return
 	} else if !found {
-		diags.AddError("Failed to read Airflow DAG after update", fmt.Sprintf("DAG %q not found", dagID))
+		diags.AddError("Failed to read Airflow DAG after update", dagNotFoundDetail(ctx, r.config, dagID, m.Fileloc.ValueString()))
+		return
 	}
+
+	reportImportErrors(ctx, r.config, m.dagDetailsModel, dagID, m.Fileloc.ValueString(), m.FailOnImportErrors.ValueBool(), diags)
 }
 
-// readInto fetches the DAG identified by m.ID and populates m (except delete_dag,
-// which is Terraform-only). Returns false (without diagnostics) when the DAG no
-// longer exists.
-func (r *dagResource) readInto(m *dagResourceModel, diags *diag.Diagnostics) (found bool) {
+// readInto fetches the DAG identified by m.ID and populates m (except
+// delete_dag and fail_on_import_errors, which are Terraform-only). Returns
+// false (without diagnostics) when the DAG no longer exists.
+func (r *dagResource) readInto(ctx context.Context, m *dagResourceModel, diags *diag.Diagnostics) (found bool) {
 	id := m.ID.ValueString()
 
 	dag, httpResp, err := r.config.ApiClient.DAGApi.GetDag(r.config.AuthContext, id).Execute()
@@ -242,6 +302,16 @@ func (r *dagResource) readInto(m *dagResourceModel, diags *diag.Diagnostics) (fo
 	m.FileToken = types.StringValue(dag.GetFileToken())
 	m.Fileloc = types.StringValue(dag.GetFileloc())
 	m.RootDagID = types.StringValue(derefString(dag.RootDagId.Get()))
+
+	// The details are informational, so failing to read them (e.g. without
+	// the permission to) must not block refresh, and with it destroy.
+	details, httpResp, err := getDagDetails(ctx, r.config, id)
+	if err != nil {
+		diags.AddWarning("Failed to read Airflow DAG details", clientError("read", id, httpResp, err))
+		m.dagDetailsModel = m.dagDetailsModel.knownOrNull()
+		return true
+	}
+	m.dagDetailsModel = details.toModel(ctx, diags)
 	return true
 }
 
@@ -0,0 +1,226 @@
+package fwprovider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/drfaust92/terraform-provider-airflow/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource              = &dagReparseResource{}
+	_ resource.ResourceWithConfigure = &dagReparseResource{}
+)
+
+func newDagReparseResource() resource.Resource {
+	return &dagReparseResource{}
+}
+
+type dagReparseResource struct {
+	config client.ProviderConfig
+}
+
+type dagReparseResourceModel struct {
+	ID             types.String   `tfsdk:"id"`
+	FileToken      types.String   `tfsdk:"file_token"`
+	DagID          types.String   `tfsdk:"dag_id"`
+	Triggers       types.Map      `tfsdk:"triggers"`
+	WaitForParse   types.Bool     `tfsdk:"wait_for_parse"`
+	LastParsedTime types.String   `tfsdk:"last_parsed_time"`
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *dagReparseResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dag_reparse"
+}
+
+func (r *dagReparseResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Requests Airflow to reparse a DAG file right away instead of waiting for the next DAG processor scan. Requires Airflow 3 (API v2). The reparse is requested on create and again whenever `triggers` change; destroying the resource does nothing in Airflow.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The file token of the reparsed file.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"file_token": schema.StringAttribute{
+				MarkdownDescription: "The token of the DAG file to reparse, e.g. the `file_token` of an `airflow_dag` resource or data source.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"dag_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of a DAG defined in the file. Required by `wait_for_parse`, which watches this DAG's `last_parsed_time`.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary values that request a new reparse when changed, e.g. a hash of the DAG file contents.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"wait_for_parse": schema.BoolAttribute{
+				MarkdownDescription: "Wait until the `last_parsed_time` of `dag_id` advances past its value before the reparse was requested. The wait counts against the `create` timeout.",
+				Optional:            true,
+				Validators: []validator.Bool{
+					boolvalidator.AlsoRequires(path.MatchRoot("dag_id")),
+				},
+			},
+			"last_parsed_time": schema.StringAttribute{
+				MarkdownDescription: "The `last_parsed_time` of `dag_id` after the reparse was requested (and, with `wait_for_parse`, completed).",
+				Computed:            true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true}),
+		},
+	}
+}
+
+func (r *dagReparseResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	cfg, ok := req.ProviderData.(client.ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.config = cfg
+}
+
+func (r *dagReparseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan dagReparseResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !r.config.IsV2() {
+		resp.Diagnostics.AddError("Unsupported Airflow version", "Reparsing a DAG file requires Airflow 3 (API v2).")
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, 10*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	start := time.Now()
+
+	fileToken := plan.FileToken.ValueString()
+	dagID := plan.DagID.ValueString()
+
+	var before string
+	if dagID != "" {
+		details, httpResp, err := getDagDetails(ctx, r.config, dagID)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to read Airflow DAG", clientError("read", dagID, httpResp, err))
+			return
+		}
+		before = details.LastParsedTime
+	}
+
+	httpResp, err := r.config.Do(ctx, http.MethodPut, fmt.Sprintf("/parseDagFile/%s", url.PathEscape(fileToken)), nil, nil, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to reparse Airflow DAG file", clientError("reparse", fileToken, httpResp, err))
+		return
+	}
+
+	plan.ID = types.StringValue(fileToken)
+	plan.LastParsedTime = types.StringValue(before)
+	if plan.WaitForParse.ValueBool() {
+		lastParsed, ok := r.waitForParse(ctx, dagID, before, createTimeout-time.Since(start), &resp.Diagnostics)
+		if !ok {
+			return
+		}
+		plan.LastParsedTime = types.StringValue(lastParsed)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read keeps the prior state: the reparse request has no server-side object
+// to refresh.
+func (r *dagReparseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state dagReparseResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update only stores wait_for_parse and timeouts changes; every other
+// attribute requires replacement.
+func (r *dagReparseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state dagReparseResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = state.ID
+	plan.LastParsedTime = state.LastParsedTime
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *dagReparseResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+// waitForParse polls the DAG dagID until its last_parsed_time differs from
+// before and returns the new value.
+func (r *dagReparseResource) waitForParse(ctx context.Context, dagID, before string, timeout time.Duration, diags *diag.Diagnostics) (string, bool) {
+	deadline := time.Now().Add(timeout)
+	for {
+		details, httpResp, err := getDagDetails(ctx, r.config, dagID)
+		if err != nil {
+			diags.AddError("Failed to poll Airflow DAG", clientError("read", dagID, httpResp, err))
+			return "", false
+		}
+		if details.LastParsedTime != before {
+			return details.LastParsedTime, true
+		}
+
+		if time.Now().After(deadline) {
+			diags.AddError("Timed out waiting for DAG", fmt.Sprintf("DAG %q was not reparsed within %s", dagID, timeout))
+			return "", false
+		}
+
+		select {
+		case <-ctx.Done():
+			diags.AddError("Cancelled waiting for DAG", ctx.Err().Error())
+			return "", false
+		case <-time.After(5 * time.Second):
+		}
+	}
+}
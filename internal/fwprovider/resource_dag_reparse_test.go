@@ -0,0 +1,58 @@
+package fwprovider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAirflowDagReparse_basic(t *testing.T) {
+	if os.Getenv("SKIP_AIRFLOW_DAG_TESTS") == "true" {
+		t.Skip("Skipping Airflow DAG tests")
+	}
+	if os.Getenv("AIRFLOW_API_BASE_PATH") == "" {
+		t.Skip("Reparsing DAG files requires Airflow 3 (API v2)")
+	}
+
+	resourceName := "airflow_dag_reparse.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAirflowDagReparseConfig("1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "file_token", "data.airflow_dag.test", "file_token"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_parsed_time"),
+				),
+			},
+			{
+				Config: testAccAirflowDagReparseConfig("2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "triggers.version", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_parsed_time"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAirflowDagReparseConfig(version string) string {
+	return fmt.Sprintf(`
+data "airflow_dag" "test" {
+  dag_id = %[1]q
+}
+
+resource "airflow_dag_reparse" "test" {
+  file_token     = data.airflow_dag.test.file_token
+  dag_id         = data.airflow_dag.test.dag_id
+  wait_for_parse = true
+
+  triggers = {
+    version = %[2]q
+  }
+}
+`, dagId, version)
+}
@@ -4,19 +4,23 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/url"
 	"strings"
 	"time"
 
 	"github.com/apache/airflow-client-go/airflow"
 	"github.com/drfaust92/terraform-provider-airflow/internal/client"
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -26,6 +30,13 @@ var (
 	_ resource.ResourceWithImportState = &dagRunResource{}
 )
 
+// Values of the on_destroy attribute.
+const (
+	dagRunOnDestroyDelete     = "delete"
+	dagRunOnDestroyKeep       = "keep"
+	dagRunOnDestroyMarkFailed = "mark_failed"
+)
+
 func newDagRunResource() resource.Resource {
 	return &dagRunResource{}
 }
@@ -35,12 +46,17 @@ type dagRunResource struct {
 }
 
 type dagRunResourceModel struct {
-	ID       types.String   `tfsdk:"id"`
-	DagID    types.String   `tfsdk:"dag_id"`
-	DagRunID types.String   `tfsdk:"dag_run_id"`
-	Conf     types.Map      `tfsdk:"conf"`
-	State    types.String   `tfsdk:"state"`
-	Timeouts timeouts.Value `tfsdk:"timeouts"`
+	ID           types.String   `tfsdk:"id"`
+	DagID        types.String   `tfsdk:"dag_id"`
+	DagRunID     types.String   `tfsdk:"dag_run_id"`
+	Conf         types.Map      `tfsdk:"conf"`
+	DesiredState types.String   `tfsdk:"desired_state"`
+	Note         types.String   `tfsdk:"note"`
+	OnDestroy    types.String   `tfsdk:"on_destroy"`
+	State        types.String   `tfsdk:"state"`
+	WaitForDag   types.Bool     `tfsdk:"wait_for_dag"`
+	UnpauseDag   types.Bool     `tfsdk:"unpause_dag"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *dagRunResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -84,10 +100,38 @@ func (r *dagRunResource) Schema(ctx context.Context, _ resource.SchemaRequest, r
 					mapplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"desired_state": schema.StringAttribute{
+				MarkdownDescription: "The state to set the DAG run to, one of `success`, `failed` or `queued`. When set, the run is moved to this state right after it is triggered instead of waiting for it to succeed, and changing it later updates the existing run in place rather than replacing it.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("success", "failed", "queued"),
+				},
+			},
+			"note": schema.StringAttribute{
+				MarkdownDescription: "A free-form note attached to the DAG run. Can be updated in place.",
+				Optional:            true,
+			},
+			"on_destroy": schema.StringAttribute{
+				MarkdownDescription: "What to do with the DAG run when the resource is destroyed or replaced: `delete` removes the run (the default), `keep` only removes it from state, and `mark_failed` keeps the run but marks it as failed if it is still queued or running.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(dagRunOnDestroyDelete),
+				Validators: []validator.String{
+					stringvalidator.OneOf(dagRunOnDestroyDelete, dagRunOnDestroyKeep, dagRunOnDestroyMarkFailed),
+				},
+			},
 			"state": schema.StringAttribute{
 				MarkdownDescription: "The DAG state.",
 				Computed:            true,
 			},
+			"wait_for_dag": schema.BoolAttribute{
+				MarkdownDescription: "Wait for the scheduler to parse the DAG (it exists and is active) before triggering it, e.g. right after its file is deployed. The wait counts against the `create` timeout.",
+				Optional:            true,
+			},
+			"unpause_dag": schema.BoolAttribute{
+				MarkdownDescription: "Unpause the DAG for the run if it is paused. The DAG is paused again once the run has finished, whether it succeeded or failed, or right away when `desired_state` is `success` or `failed`. It is left unpaused, with a warning, when the run is not awaited to completion (`desired_state = \"queued\"`) or the wait times out, since pausing it would stall a run that is still queued or running. Do not combine with an `airflow_dag` resource managing `is_paused` for the same DAG.",
+				Optional:            true,
+			},
 		},
 		Blocks: map[string]schema.Block{
 			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true}),
@@ -125,28 +169,76 @@ func (r *dagRunResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	start := time.Now()
 	dagID := plan.DagID.ValueString()
+
+	if plan.WaitForDag.ValueBool() && !r.waitForDag(ctx, dagID, createTimeout, &resp.Diagnostics) {
+		return
+	}
+
 	dagRun := *airflow.NewDAGRunWithDefaults()
 	if !plan.DagRunID.IsNull() && !plan.DagRunID.IsUnknown() {
 		dagRun.SetDagRunId(plan.DagRunID.ValueString())
 	}
-	if conf := r.expandConf(ctx, plan.Conf, &resp.Diagnostics); conf != nil {
+	if conf := expandConf(ctx, plan.Conf, &resp.Diagnostics); conf != nil {
 		dagRun.SetConf(conf)
 	}
+	if !plan.Note.IsNull() && !plan.Note.IsUnknown() {
+		dagRun.SetNote(plan.Note.ValueString())
+	}
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	repause := false
+	if plan.UnpauseDag.ValueBool() {
+		dag, httpResp, err := r.config.ApiClient.DAGApi.GetDag(r.config.AuthContext, dagID).Execute()
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to read Airflow DAG", clientError("read", dagID, httpResp, err))
+			return
+		}
+		if derefBool(dag.IsPaused.Get()) {
+			if !r.setDagPaused(dagID, false, &resp.Diagnostics) {
+				return
+			}
+			repause = true
+		}
+	}
+
 	res, httpResp, err := r.config.ApiClient.DAGRunApi.PostDagRun(r.config.AuthContext, dagID).DAGRun(dagRun).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to create Airflow DAG run", clientError("create", dagID, httpResp, err))
+		if repause {
+			// Nothing was triggered, so restore the DAG.
+			r.setDagPaused(dagID, true, &resp.Diagnostics)
+		}
 		return
 	}
 
 	id := fmt.Sprintf("%s:%s", dagID, res.GetDagRunId())
 	plan.ID = types.StringValue(id)
 
-	if !r.waitForRun(ctx, id, createTimeout, &resp.Diagnostics) {
+	// An explicit desired_state replaces the natural outcome of the run, so
+	// there is nothing to wait for.
+	var finished bool
+	if !plan.DesiredState.IsNull() {
+		r.setState(dagID, res.GetDagRunId(), plan.DesiredState.ValueString(), &resp.Diagnostics)
+		finished = !resp.Diagnostics.HasError() && plan.DesiredState.ValueString() != "queued"
+	} else {
+		finished = r.waitForRun(ctx, id, createTimeout-time.Since(start), &resp.Diagnostics)
+	}
+
+	// Pausing the DAG while its run is still queued or running would stall
+	// the run, so the DAG is only paused again once the run has finished.
+	if repause && finished {
+		r.setDagPaused(dagID, true, &resp.Diagnostics)
+	} else if repause {
+		resp.Diagnostics.AddWarning(
+			"Airflow DAG left unpaused",
+			fmt.Sprintf("DAG %q was unpaused for run %q and is left unpaused because the run has not finished. Pause it again once the run is done.", dagID, res.GetDagRunId()),
+		)
+	}
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
@@ -179,15 +271,39 @@ func (r *dagRunResource) Read(ctx context.Context, req resource.ReadRequest, res
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
-// Update is unreachable in practice: every configurable attribute uses
-// RequiresReplace, so any change forces a replace rather than an in-place
-// update. It exists only to satisfy the resource.Resource interface.
+// Update changes the state and note of the existing DAG run in place; every
+// other configurable attribute uses RequiresReplace.
 func (r *dagRunResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan dagRunResourceModel
+	var plan, state dagRunResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	dagID, dagRunID, err := parseDagRunID(plan.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid DAG run ID", err.Error())
+		return
+	}
+
+	if !plan.DesiredState.IsNull() && !plan.DesiredState.Equal(state.DesiredState) {
+		r.setState(dagID, dagRunID, plan.DesiredState.ValueString(), &resp.Diagnostics)
+	}
+	if !plan.Note.Equal(state.Note) {
+		r.setNote(ctx, dagID, dagRunID, plan.Note, &resp.Diagnostics)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if found := r.readInto(ctx, &plan, &resp.Diagnostics); resp.Diagnostics.HasError() {
+		return
+	} else if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
@@ -204,6 +320,25 @@ func (r *dagRunResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	// State written before on_destroy existed has it null, which means delete.
+	switch state.OnDestroy.ValueString() {
+	case dagRunOnDestroyKeep:
+		return
+	case dagRunOnDestroyMarkFailed:
+		dagRun, httpResp, err := r.config.ApiClient.DAGRunApi.GetDagRun(r.config.AuthContext, dagID, dagRunID).Execute()
+		if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+			return
+		}
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to read Airflow DAG run", clientError("read", state.ID.ValueString(), httpResp, err))
+			return
+		}
+		if s := dagRun.GetState(); s == airflow.DAGSTATE_QUEUED || s == airflow.DAGSTATE_RUNNING {
+			r.setState(dagID, dagRunID, "failed", &resp.Diagnostics)
+		}
+		return
+	}
+
 	httpResp, err := r.config.ApiClient.DAGRunApi.DeleteDagRun(r.config.AuthContext, dagID, dagRunID).Execute()
 	if err != nil {
 		if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
@@ -215,10 +350,51 @@ func (r *dagRunResource) Delete(ctx context.Context, req resource.DeleteRequest,
 
 func (r *dagRunResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// on_destroy is Terraform-only; start imported runs at its default.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("on_destroy"), dagRunOnDestroyDelete)...)
 }
 
-// readInto fetches the DAG run identified by m.ID and populates m. Returns false
-// (without diagnostics) when the DAG run no longer exists.
+// setState patches the state of an existing DAG run.
+func (r *dagRunResource) setState(dagID, dagRunID, state string, diags *diag.Diagnostics) {
+	body := *airflow.NewUpdateDagRunState()
+	body.SetState(state)
+
+	_, httpResp, err := r.config.ApiClient.DAGRunApi.UpdateDagRunState(r.config.AuthContext, dagID, dagRunID).UpdateDagRunState(body).Execute()
+	if err != nil {
+		diags.AddError("Failed to update Airflow DAG run state", clientError("update", dagID+":"+dagRunID, httpResp, err))
+	}
+}
+
+// setNote sets (or, when note is null, clears) the note of an existing DAG run.
+// API v1 has a dedicated setNote endpoint; API v2 dropped it in favour of
+// patching the run itself, which the generated client does not model.
+func (r *dagRunResource) setNote(ctx context.Context, dagID, dagRunID string, note types.String, diags *diag.Diagnostics) {
+	id := dagID + ":" + dagRunID
+
+	if r.config.IsV2() {
+		body := map[string]interface{}{"note": nil}
+		if !note.IsNull() {
+			body["note"] = note.ValueString()
+		}
+		path := fmt.Sprintf("/dags/%s/dagRuns/%s", url.PathEscape(dagID), url.PathEscape(dagRunID))
+		if httpResp, err := r.config.Do(ctx, http.MethodPatch, path, nil, body, nil); err != nil {
+			diags.AddError("Failed to update Airflow DAG run note", clientError("update", id, httpResp, err))
+		}
+		return
+	}
+
+	body := *airflow.NewSetDagRunNote()
+	body.SetNote(note.ValueString())
+
+	_, httpResp, err := r.config.ApiClient.DAGRunApi.SetDagRunNote(r.config.AuthContext, dagID, dagRunID).SetDagRunNote(body).Execute()
+	if err != nil {
+		diags.AddError("Failed to update Airflow DAG run note", clientError("update", id, httpResp, err))
+	}
+}
+
+// readInto fetches the DAG run identified by m.ID and populates m (except
+// desired_state, which is Terraform-only). Returns false (without diagnostics)
+// when the DAG run no longer exists.
 func (r *dagRunResource) readInto(ctx context.Context, m *dagRunResourceModel, diags *diag.Diagnostics) (found bool) {
 	dagID, dagRunID, err := parseDagRunID(m.ID.ValueString())
 	if err != nil {
@@ -238,6 +414,7 @@ func (r *dagRunResource) readInto(ctx context.Context, m *dagRunResourceModel, d
 	m.DagID = types.StringValue(dagRun.GetDagId())
 	m.DagRunID = types.StringValue(dagRun.GetDagRunId())
 	m.State = types.StringValue(string(dagRun.GetState()))
+	setOptionalString(&m.Note, derefString(dagRun.Note.Get()))
 
 	conf := dagRun.GetConf()
 	confMap := make(map[string]string, len(conf))
@@ -253,7 +430,9 @@ func (r *dagRunResource) readInto(ctx context.Context, m *dagRunResourceModel, d
 
 // waitForRun polls until the DAG run reaches the "success" state, mirroring the
 // SDKv2 StateChangeConf (pending: queued/running/success, target: success).
-func (r *dagRunResource) waitForRun(ctx context.Context, id string, timeout time.Duration, diags *diag.Diagnostics) bool {
+// Any other final state is an error. It returns whether the run has finished,
+// successfully or not.
+func (r *dagRunResource) waitForRun(ctx context.Context, id string, timeout time.Duration, diags *diag.Diagnostics) (finished bool) {
 	dagID, dagRunID, err := parseDagRunID(id)
 	if err != nil {
 		diags.AddError("Invalid DAG run ID", err.Error())
@@ -275,7 +454,7 @@ func (r *dagRunResource) waitForRun(ctx context.Context, id string, timeout time
 			// keep waiting
 		default:
 			diags.AddError("Unexpected DAG run state", fmt.Sprintf("DAG run %q entered unexpected state %q while waiting for success", id, state))
-			return false
+			return true
 		}
 
 		if time.Now().After(deadline) {
@@ -292,7 +471,52 @@ func (r *dagRunResource) waitForRun(ctx context.Context, id string, timeout time
 	}
 }
 
-func (r *dagRunResource) expandConf(ctx context.Context, m types.Map, diags *diag.Diagnostics) map[string]interface{} {
+// waitForDag polls until the DAG exists and is active, i.e. the scheduler has
+// parsed its file. API v2 does not report is_active, so there existence is
+// enough.
+func (r *dagRunResource) waitForDag(ctx context.Context, dagID string, timeout time.Duration, diags *diag.Diagnostics) bool {
+	deadline := time.Now().Add(timeout)
+	for {
+		dag, httpResp, err := r.config.ApiClient.DAGApi.GetDag(r.config.AuthContext, dagID).Execute()
+		switch {
+		case httpResp != nil && httpResp.StatusCode == http.StatusNotFound:
+			// not parsed yet; keep waiting
+		case err != nil:
+			diags.AddError("Failed to poll Airflow DAG", clientError("read", dagID, httpResp, err))
+			return false
+		case dag.IsActive.Get() == nil || *dag.IsActive.Get():
+			return true
+		}
+
+		if time.Now().After(deadline) {
+			diags.AddError("Timed out waiting for DAG", fmt.Sprintf("DAG %q was not parsed and active within %s", dagID, timeout))
+			return false
+		}
+
+		select {
+		case <-ctx.Done():
+			diags.AddError("Cancelled waiting for DAG", ctx.Err().Error())
+			return false
+		case <-time.After(5 * time.Second):
+		}
+	}
+}
+
+// setDagPaused patches the DAG's is_paused flag.
+func (r *dagRunResource) setDagPaused(dagID string, paused bool, diags *diag.Diagnostics) bool {
+	dag := *airflow.NewDAG()
+	dag.SetIsPaused(paused)
+
+	_, httpResp, err := r.config.ApiClient.DAGApi.PatchDag(r.config.AuthContext, dagID).DAG(dag).Execute()
+	if err != nil {
+		diags.AddError("Failed to update Airflow DAG", clientError("update", dagID, httpResp, err))
+		return false
+	}
+	return true
+}
+
+// expandConf converts a Terraform map of strings into a DAG run conf.
+func expandConf(ctx context.Context, m types.Map, diags *diag.Diagnostics) map[string]interface{} {
 	if m.IsNull() || m.IsUnknown() {
 		return nil
 	}
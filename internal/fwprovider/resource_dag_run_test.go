@@ -100,6 +100,124 @@ func TestAccAirflowDagRun_conf(t *testing.T) {
 	})
 }
 
+func TestAccAirflowDagRun_desiredStateAndNote(t *testing.T) {
+	if os.Getenv("SKIP_AIRFLOW_DAG_TESTS") == "true" {
+		t.Skip("Skipping Airflow DAG tests")
+	}
+
+	dagRunId := acctest.RandomWithPrefix("tf-acc-test")
+
+	resourceName := "airflow_dag_run.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckAirflowDagRunCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAirflowDagRunConfigDesiredState(dagId, dagRunId, "failed", "first"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "dag_run_id", dagRunId),
+					resource.TestCheckResourceAttr(resourceName, "desired_state", "failed"),
+					resource.TestCheckResourceAttr(resourceName, "state", "failed"),
+					resource.TestCheckResourceAttr(resourceName, "note", "first"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"desired_state"},
+			},
+			{
+				// Changing desired_state and note updates the same run in place.
+				Config: testAccAirflowDagRunConfigDesiredState(dagId, dagRunId, "success", "second"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "dag_run_id", dagRunId),
+					resource.TestCheckResourceAttr(resourceName, "state", "success"),
+					resource.TestCheckResourceAttr(resourceName, "note", "second"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAirflowDagRun_onDestroy(t *testing.T) {
+	if os.Getenv("SKIP_AIRFLOW_DAG_TESTS") == "true" {
+		t.Skip("Skipping Airflow DAG tests")
+	}
+
+	for _, onDestroy := range []string{"keep", "mark_failed"} {
+		t.Run(onDestroy, func(t *testing.T) {
+			dagRunId := acctest.RandomWithPrefix("tf-acc-test")
+			wantState := "queued"
+			if onDestroy == "mark_failed" {
+				wantState = "failed"
+			}
+
+			resource.Test(t, resource.TestCase{
+				PreCheck:                 func() { testAccPreCheck(t) },
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				CheckDestroy:             testAccCheckAirflowDagRunKept(dagId, dagRunId, wantState),
+				Steps: []resource.TestStep{
+					{
+						Config: testAccAirflowDagRunConfigOnDestroy(dagId, dagRunId, onDestroy),
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr("airflow_dag_run.test", "on_destroy", onDestroy),
+							resource.TestCheckResourceAttr("airflow_dag_run.test", "state", "queued"),
+						),
+					},
+				},
+			})
+		})
+	}
+}
+
+// testAccCheckAirflowDagRunKept asserts the DAG run survived destroy in the
+// expected state, then deletes it so it does not leak into other tests.
+func testAccCheckAirflowDagRunKept(dagID, dagRunID, wantState string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		cfg, err := testAccProviderConfig()
+		if err != nil {
+			return err
+		}
+
+		dagRun, _, err := cfg.ApiClient.DAGRunApi.GetDagRun(cfg.AuthContext, dagID, dagRunID).Execute()
+		if err != nil {
+			return fmt.Errorf("expected Airflow DagRun (%s:%s) to be kept: %s", dagID, dagRunID, err)
+		}
+		if got := string(dagRun.GetState()); got != wantState {
+			return fmt.Errorf("expected kept Airflow DagRun (%s:%s) to be %q, got %q", dagID, dagRunID, wantState, got)
+		}
+
+		_, err = cfg.ApiClient.DAGRunApi.DeleteDagRun(cfg.AuthContext, dagID, dagRunID).Execute()
+		return err
+	}
+}
+
+func TestAccAirflowDagRun_waitForDag(t *testing.T) {
+	if os.Getenv("SKIP_AIRFLOW_DAG_TESTS") == "true" {
+		t.Skip("Skipping Airflow DAG tests")
+	}
+
+	resourceName := "airflow_dag_run.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckAirflowDagRunCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAirflowDagRunConfigWaitForDag(dagId),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "dag_id", dagId),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_dag", "true"),
+					resource.TestCheckResourceAttr(resourceName, "unpause_dag", "true"),
+					resource.TestCheckResourceAttr(resourceName, "state", "success"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckAirflowDagRunCheckDestroy(s *terraform.State) error {
 	cfg, err := testAccProviderConfig()
 	if err != nil {
@@ -174,3 +292,45 @@ resource "airflow_dag_run" "test" {
 }
 `, dagId)
 }
+
+func testAccAirflowDagRunConfigDesiredState(dagId, dagRunId, state, note string) string {
+	return fmt.Sprintf(`
+resource "airflow_dag" "test" {
+  dag_id    = %[1]q
+  is_paused = true
+}
+
+resource "airflow_dag_run" "test" {
+  dag_id        = airflow_dag.test.dag_id
+  dag_run_id    = %[2]q
+  desired_state = %[3]q
+  note          = %[4]q
+}
+`, dagId, dagRunId, state, note)
+}
+
+func testAccAirflowDagRunConfigOnDestroy(dagId, dagRunId, onDestroy string) string {
+	return fmt.Sprintf(`
+resource "airflow_dag" "test" {
+  dag_id    = %[1]q
+  is_paused = true
+}
+
+resource "airflow_dag_run" "test" {
+  dag_id        = airflow_dag.test.dag_id
+  dag_run_id    = %[2]q
+  desired_state = "queued"
+  on_destroy    = %[3]q
+}
+`, dagId, dagRunId, onDestroy)
+}
+
+func testAccAirflowDagRunConfigWaitForDag(dagId string) string {
+	return fmt.Sprintf(`
+resource "airflow_dag_run" "test" {
+  dag_id       = %[1]q
+  wait_for_dag = true
+  unpause_dag  = true
+}
+`, dagId)
+}
@@ -32,6 +32,12 @@ func TestAccAirflowDag_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(resourceName, "file_token"),
 					resource.TestCheckResourceAttrSet(resourceName, "fileloc"),
 					resource.TestCheckResourceAttr(resourceName, "root_dag_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "tags.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.0", "example"),
+					resource.TestCheckResourceAttr(resourceName, "owners.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "schedule_interval"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_parsed_time"),
+					resource.TestCheckResourceAttr(resourceName, "has_import_errors", "false"),
 				),
 			},
 			{
@@ -0,0 +1,343 @@
+package fwprovider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/apache/airflow-client-go/airflow"
+	"github.com/drfaust92/terraform-provider-airflow/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                     = &dagsPausedResource{}
+	_ resource.ResourceWithConfigure        = &dagsPausedResource{}
+	_ resource.ResourceWithConfigValidators = &dagsPausedResource{}
+)
+
+func newDagsPausedResource() resource.Resource {
+	return &dagsPausedResource{}
+}
+
+type dagsPausedResource struct {
+	config client.ProviderConfig
+}
+
+type dagsPausedResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	DagIDPattern   types.String `tfsdk:"dag_id_pattern"`
+	Tags           types.Set    `tfsdk:"tags"`
+	IsPaused       types.Bool   `tfsdk:"is_paused"`
+	DagIDs         types.List   `tfsdk:"dag_ids"`
+	PreviousPaused types.Map    `tfsdk:"previous_paused"`
+}
+
+func (r *dagsPausedResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dags_paused"
+}
+
+func (r *dagsPausedResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Pauses (or unpauses) every active Airflow DAG matching a DAG ID pattern and/or tags in bulk, e.g. for a maintenance window. On delete, each matched DAG is restored to the paused state it had before this resource changed it.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The filter identifier, built from `dag_id_pattern` and `tags`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"dag_id_pattern": schema.StringAttribute{
+				MarkdownDescription: "Only match DAGs whose ID contains this pattern. SQL `LIKE` wildcards (`%`, `_`) are supported.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "Only match DAGs with any of these tags.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"is_paused": schema.BoolAttribute{
+				MarkdownDescription: "Whether the matched DAGs are paused. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"dag_ids": schema.ListAttribute{
+				MarkdownDescription: "The IDs of the DAGs currently matching the filter.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"previous_paused": schema.MapAttribute{
+				MarkdownDescription: "The paused state each matched DAG had before this resource first changed it, keyed by DAG ID. Used to restore the DAGs on delete.",
+				Computed:            true,
+				ElementType:         types.BoolType,
+			},
+		},
+	}
+}
+
+func (r *dagsPausedResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	cfg, ok := req.ProviderData.(client.ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.config = cfg
+}
+
+func (r *dagsPausedResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.AtLeastOneOf(
+			path.MatchRoot("dag_id_pattern"),
+			path.MatchRoot("tags"),
+		),
+	}
+}
+
+func (r *dagsPausedResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan dagsPausedResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tags := r.expandTags(ctx, plan.Tags, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.ID = types.StringValue(dagsPausedID(plan.DagIDPattern.ValueString(), tags))
+
+	r.apply(ctx, &plan, map[string]bool{}, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *dagsPausedResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state dagsPausedResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tags := r.expandTags(ctx, state.Tags, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dags, httpResp, err := listDags(ctx, r.config, state.DagIDPattern.ValueString(), tags)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list Airflow DAGs", clientError("list", state.ID.ValueString(), httpResp, err))
+		return
+	}
+
+	// Report a DAG that is not in the configured state (e.g. one unpaused by
+	// hand, or a new DAG matching the filter) as drift on is_paused, so the
+	// next apply patches the matched DAGs again.
+	dagIDs := make([]string, 0, len(dags))
+	for _, dag := range dags {
+		dagIDs = append(dagIDs, dag.DagID)
+		if dag.IsPaused != state.IsPaused.ValueBool() {
+			state.IsPaused = types.BoolValue(dag.IsPaused)
+		}
+	}
+
+	dagIDsValue, d := types.ListValueFrom(ctx, types.StringType, dagIDs)
+	resp.Diagnostics.Append(d...)
+	state.DagIDs = dagIDsValue
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *dagsPausedResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state dagsPausedResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	previous := make(map[string]bool, len(state.PreviousPaused.Elements()))
+	resp.Diagnostics.Append(state.PreviousPaused.ElementsAs(ctx, &previous, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &plan, previous, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete restores every matched DAG to its previous paused state. is_paused
+// cannot tell which DAGs were changed, as Read sets it to any drifted value,
+// so every DAG in previous_paused is restored.
+func (r *dagsPausedResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state dagsPausedResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	previous := make(map[string]bool, len(state.PreviousPaused.Elements()))
+	resp.Diagnostics.Append(state.PreviousPaused.ElementsAs(ctx, &previous, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, dagID := range sortedKeys(previous) {
+		dag := *airflow.NewDAG()
+		dag.SetIsPaused(previous[dagID])
+		_, httpResp, err := r.config.ApiClient.DAGApi.PatchDag(r.config.AuthContext, dagID).DAG(dag).UpdateMask([]string{"is_paused"}).Execute()
+		if err != nil {
+			if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+				continue
+			}
+			resp.Diagnostics.AddError("Failed to restore Airflow DAG", clientError("update", dagID, httpResp, err))
+		}
+	}
+}
+
+// apply records the paused state of matched DAGs not yet in previous, patches
+// every matched DAG to m.IsPaused, and populates the computed attributes.
+func (r *dagsPausedResource) apply(ctx context.Context, m *dagsPausedResourceModel, previous map[string]bool, diags *diag.Diagnostics) {
+	pattern := m.DagIDPattern.ValueString()
+	tags := r.expandTags(ctx, m.Tags, diags)
+	if diags.HasError() {
+		return
+	}
+
+	dags, httpResp, err := listDags(ctx, r.config, pattern, tags)
+	if err != nil {
+		diags.AddError("Failed to list Airflow DAGs", clientError("list", m.ID.ValueString(), httpResp, err))
+		return
+	}
+
+	dagIDs := make([]string, 0, len(dags))
+	for _, dag := range dags {
+		dagIDs = append(dagIDs, dag.DagID)
+		if _, ok := previous[dag.DagID]; !ok {
+			previous[dag.DagID] = dag.IsPaused
+		}
+	}
+
+	// The collection PATCH applies to a single page of matches, so walk the
+	// pages just like listPages does.
+	if len(dags) > 0 {
+		dag := *airflow.NewDAG()
+		dag.SetIsPaused(m.IsPaused.ValueBool())
+		for offset := int32(0); ; offset += listPageSize {
+			patchReq := r.config.ApiClient.DAGApi.PatchDags(r.config.AuthContext).
+				DagIdPattern(dagIDPatternOrAll(pattern)).
+				DAG(dag).
+				UpdateMask([]string{"is_paused"}).
+				Limit(listPageSize).
+				Offset(offset)
+			if len(tags) > 0 {
+				patchReq = patchReq.Tags(tags)
+			}
+
+			collection, httpResp, err := patchReq.Execute()
+			if err != nil {
+				diags.AddError("Failed to update Airflow DAGs", clientError("update", m.ID.ValueString(), httpResp, err))
+				return
+			}
+			if len(collection.GetDags()) < listPageSize {
+				break
+			}
+		}
+	}
+
+	dagIDsValue, d := types.ListValueFrom(ctx, types.StringType, dagIDs)
+	diags.Append(d...)
+	m.DagIDs = dagIDsValue
+
+	previousValue, d := types.MapValueFrom(ctx, types.BoolType, previous)
+	diags.Append(d...)
+	m.PreviousPaused = previousValue
+}
+
+func (r *dagsPausedResource) expandTags(ctx context.Context, s types.Set, diags *diag.Diagnostics) []string {
+	if s.IsNull() || s.IsUnknown() {
+		return nil
+	}
+
+	tags := make([]string, 0, len(s.Elements()))
+	diags.Append(s.ElementsAs(ctx, &tags, false)...)
+	sort.Strings(tags)
+	return tags
+}
+
+// dagItem is a DAG as listed by listDags.
+type dagItem struct {
+	DagID    string `json:"dag_id"`
+	IsPaused bool   `json:"is_paused"`
+}
+
+// listDags lists the active DAGs matching pattern and having any of tags.
+func listDags(ctx context.Context, cfg client.ProviderConfig, pattern string, tags []string) ([]dagItem, *http.Response, error) {
+	query := url.Values{"dag_id_pattern": {dagIDPatternOrAll(pattern)}}
+	if len(tags) > 0 {
+		query["tags"] = tags
+	}
+	return listPages[dagItem](ctx, cfg, "/dags", query, "dags", nil, 0)
+}
+
+// dagIDPatternOrAll returns pattern, or a pattern matching every DAG when it is
+// empty (the collection PATCH endpoint requires one).
+func dagIDPatternOrAll(pattern string) string {
+	if pattern == "" {
+		return "%"
+	}
+	return pattern
+}
+
+// dagsPausedID builds the airflow_dags_paused ID from its filters.
+func dagsPausedID(pattern string, tags []string) string {
+	return fmt.Sprintf("%s:%s", pattern, strings.Join(tags, ","))
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
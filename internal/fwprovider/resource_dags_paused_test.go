@@ -0,0 +1,164 @@
+package fwprovider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/drfaust92/terraform-provider-airflow/internal/client"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccAirflowDagsPaused_basic(t *testing.T) {
+	if os.Getenv("SKIP_AIRFLOW_DAG_TESTS") == "true" {
+		t.Skip("Skipping Airflow DAG tests")
+	}
+
+	resourceName := "airflow_dags_paused.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckAirflowDagsPausedRestored,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAirflowDagsPausedConfig(true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "dag_id_pattern", "example_bash"),
+					resource.TestCheckResourceAttr(resourceName, "is_paused", "true"),
+					resource.TestCheckResourceAttr(resourceName, "dag_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "dag_ids.0", "example_bash_operator"),
+					resource.TestCheckResourceAttrSet(resourceName, "previous_paused.example_bash_operator"),
+				),
+			},
+			{
+				Config: testAccAirflowDagsPausedConfig(false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "is_paused", "false"),
+					resource.TestCheckResourceAttr(resourceName, "dag_ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAirflowDagsPausedRestored(s *terraform.State) error {
+	cfg, err := testAccProviderConfig()
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "airflow_dags_paused" {
+			continue
+		}
+
+		previous := rs.Primary.Attributes["previous_paused.example_bash_operator"]
+		dag, _, err := cfg.ApiClient.DAGApi.GetDag(cfg.AuthContext, "example_bash_operator").Execute()
+		if err != nil {
+			return fmt.Errorf("failed to read Airflow DAG: %w", err)
+		}
+		if got := fmt.Sprintf("%t", dag.GetIsPaused()); got != previous {
+			return fmt.Errorf("Airflow DAG is_paused is %s, expected it restored to %s", got, previous)
+		}
+	}
+
+	return nil
+}
+
+func testAccAirflowDagsPausedConfig(paused bool) string {
+	return fmt.Sprintf(`
+resource "airflow_dags_paused" "test" {
+  dag_id_pattern = "example_bash"
+  is_paused      = %[1]t
+}
+`, paused)
+}
+
+// TestDagsPausedDeleteAfterDrift verifies that destroy restores every DAG
+// after a refresh saw drift, even though Read then set is_paused to the
+// drifted value.
+func TestDagsPausedDeleteAfterDrift(t *testing.T) {
+	var mu sync.Mutex
+	paused := map[string]bool{"a": true, "b": true}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/api/v1/dags":
+			var dags []map[string]interface{}
+			for _, id := range sortedKeys(paused) {
+				dags = append(dags, map[string]interface{}{"dag_id": id, "is_paused": paused[id]})
+			}
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"dags": dags, "total_entries": len(dags)})
+		case req.Method == http.MethodPatch && strings.HasPrefix(req.URL.Path, "/api/v1/dags/"):
+			id := strings.TrimPrefix(req.URL.Path, "/api/v1/dags/")
+			var body struct {
+				IsPaused bool `json:"is_paused"`
+			}
+			_ = json.NewDecoder(req.Body).Decode(&body)
+			paused[id] = body.IsPaused
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"dag_id": id, "is_paused": body.IsPaused})
+		default:
+			http.NotFound(w, req)
+		}
+	}))
+	defer srv.Close()
+
+	cfg, err := client.NewProviderConfig(srv.URL, "", "", "", false, "/api/v1", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := &dagsPausedResource{config: cfg}
+	ctx := context.Background()
+
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+	state := tfsdk.State{Schema: schemaResp.Schema}
+	// Both DAGs were unpaused before this resource paused them.
+	previous, _ := types.MapValueFrom(ctx, types.BoolType, map[string]bool{"a": false, "b": false})
+	dagIDs, _ := types.ListValueFrom(ctx, types.StringType, []string{"a", "b"})
+	if diags := state.Set(ctx, &dagsPausedResourceModel{
+		ID:             types.StringValue("%:"),
+		DagIDPattern:   types.StringValue("%"),
+		Tags:           types.SetNull(types.StringType),
+		IsPaused:       types.BoolValue(true),
+		DagIDs:         dagIDs,
+		PreviousPaused: previous,
+	}); diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	// b is unpaused by hand, so the refresh reports is_paused = false.
+	paused["b"] = false
+	readResp := &fwresource.ReadResponse{State: state}
+	r.Read(ctx, fwresource.ReadRequest{State: state}, readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatal(readResp.Diagnostics)
+	}
+	var refreshed dagsPausedResourceModel
+	readResp.State.Get(ctx, &refreshed)
+	if refreshed.IsPaused.ValueBool() {
+		t.Fatal("drift was not reported on is_paused")
+	}
+
+	deleteResp := &fwresource.DeleteResponse{}
+	r.Delete(ctx, fwresource.DeleteRequest{State: readResp.State}, deleteResp)
+	if deleteResp.Diagnostics.HasError() {
+		t.Fatal(deleteResp.Diagnostics)
+	}
+	if want := map[string]bool{"a": false, "b": false}; !reflect.DeepEqual(paused, want) {
+		t.Errorf("after destroy paused = %v, want %v", paused, want)
+	}
+}
@@ -0,0 +1,125 @@
+package fwprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// defaultConnectionTypes maps the IDs of the example connections that
+// `airflow db init` / `airflow db migrate` create (with
+// `[database] load_default_connections`) to their connection types.
+var defaultConnectionTypes = map[string]string{
+	"airflow_db":                  "mysql",
+	"athena_default":              "athena",
+	"aws_default":                 "aws",
+	"azure_batch_default":         "azure_batch",
+	"azure_cosmos_default":        "azure_cosmos",
+	"azure_data_explorer_default": "azure_data_explorer",
+	"azure_data_lake_default":     "azure_data_lake",
+	"azure_default":               "azure",
+	"cassandra_default":           "cassandra",
+	"databricks_default":          "databricks",
+	"dingding_default":            "http",
+	"drill_default":               "drill",
+	"druid_broker_default":        "druid",
+	"druid_ingest_default":        "druid",
+	"elasticsearch_default":       "elasticsearch",
+	"emr_default":                 "emr",
+	"facebook_default":            "facebook_social",
+	"fs_default":                  "fs",
+	"ftp_default":                 "ftp",
+	"google_cloud_default":        "google_cloud_platform",
+	"hive_cli_default":            "hive_cli",
+	"hiveserver2_default":         "hiveserver2",
+	"http_default":                "http",
+	"iceberg_default":             "iceberg",
+	"impala_default":              "impala",
+	"kafka_default":               "kafka",
+	"kubernetes_default":          "kubernetes",
+	"kylin_default":               "kylin",
+	"leveldb_default":             "leveldb",
+	"livy_default":                "livy",
+	"local_mysql":                 "mysql",
+	"metastore_default":           "hive_metastore",
+	"mongo_default":               "mongo",
+	"mssql_default":               "mssql",
+	"mysql_default":               "mysql",
+	"opsgenie_default":            "http",
+	"oracle_default":              "oracle",
+	"oss_default":                 "oss",
+	"pig_cli_default":             "pig_cli",
+	"pinot_admin_default":         "pinot",
+	"pinot_broker_default":        "pinot",
+	"postgres_default":            "postgres",
+	"presto_default":              "presto",
+	"redis_default":               "redis",
+	"redshift_default":            "redshift",
+	"salesforce_default":          "salesforce",
+	"segment_default":             "segment",
+	"sftp_default":                "sftp",
+	"spark_default":               "spark",
+	"sqlite_default":              "sqlite",
+	"ssh_default":                 "ssh",
+	"tableau_default":             "tableau",
+	"tabular_default":             "tabular",
+	"teradata_default":            "teradata",
+	"trino_default":               "trino",
+	"vertica_default":             "vertica",
+	"wasb_default":                "wasb",
+	"webhdfs_default":             "hdfs",
+	"yandexcloud_default":         "yandexcloud",
+	"ydb_default":                 "ydb",
+}
+
+func newDefaultConnectionsResource() resource.Resource {
+	return &connectionPruneResource{spec: defaultConnectionsSpec}
+}
+
+// defaultConnectionsSpec deletes the default connections not listed in keep.
+var defaultConnectionsSpec = &connectionPruneSpec{
+	typeName:    "default_connections",
+	description: "Deletes the example default connections (`aws_default`, `postgres_default`...) that `airflow db init` / `airflow db migrate` create, except those listed in `keep`. A default connection is only deleted while it still has its default connection type, and one that reappears (e.g. after a database reset) is reported as drift and deleted on the next apply. Connections adopted by `airflow_connection` with `adopt_existing` are not detected: list them in `keep`, otherwise this resource deletes them on every apply and the two resources keep recreating and deleting them. Deleting this resource only removes it from the state; deleted connections are not recreated.",
+	attributes: map[string]schema.Attribute{
+		"keep": schema.SetAttribute{
+			MarkdownDescription: "IDs of default connections not to delete. Every default connection managed by `airflow_connection` with `adopt_existing` must be listed here.",
+			Optional:            true,
+			ElementType:         types.StringType,
+		},
+	},
+	idDescription: "Always `default_connections`.",
+	unmanaged:     "default connections that exist and are not kept",
+	id: func(context.Context, attributeGetter, *diag.Diagnostics) string {
+		return "default_connections"
+	},
+	filter: func(ctx context.Context, src attributeGetter, diags *diag.Diagnostics) (string, func(connectionItem) bool, bool) {
+		var keep types.Set
+		diags.Append(src.GetAttribute(ctx, path.Root("keep"), &keep)...)
+		if diags.HasError() || keep.IsUnknown() {
+			return "", nil, false
+		}
+
+		var ids []string
+		if !keep.IsNull() {
+			diags.Append(keep.ElementsAs(ctx, &ids, false)...)
+		}
+		return "", defaultConnectionMatcher(ids), true
+	},
+}
+
+// defaultConnectionMatcher matches the default connections that still have
+// their default type and are not in keep.
+func defaultConnectionMatcher(keep []string) func(connectionItem) bool {
+	kept := make(map[string]bool, len(keep))
+	for _, id := range keep {
+		kept[id] = true
+	}
+	return func(c connectionItem) bool {
+		connType, ok := defaultConnectionTypes[c.ConnectionID]
+		return ok && c.ConnType == connType && !kept[c.ConnectionID]
+	}
+}
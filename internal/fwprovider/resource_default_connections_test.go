@@ -0,0 +1,108 @@
+package fwprovider
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/apache/airflow-client-go/airflow"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccAirflowDefaultConnections_basic(t *testing.T) {
+	resourceName := "airflow_default_connections.test"
+	config := `
+resource "airflow_default_connections" "test" {
+  keep = ["postgres_default"]
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					testAccEnsureAirflowConnection(t, "http_default", "http")
+					testAccEnsureAirflowConnection(t, "postgres_default", "postgres")
+				},
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "unmanaged_connection_ids.#", "0"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "deleted_connection_ids.*", "http_default"),
+					testAccCheckAirflowConnectionExists("http_default", false),
+					testAccCheckAirflowConnectionExists("postgres_default", true),
+				),
+			},
+			{
+				// A default connection that reappears is drift.
+				PreConfig:          func() { testAccEnsureAirflowConnection(t, "http_default", "http") },
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "unmanaged_connection_ids.#", "0"),
+					testAccCheckAirflowConnectionExists("http_default", false),
+				),
+			},
+		},
+	})
+}
+
+// testAccEnsureAirflowConnection creates the connection unless it exists.
+func testAccEnsureAirflowConnection(t *testing.T, connID, connType string) {
+	cfg, err := testAccProviderConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	conn := airflow.Connection{ConnectionId: &connID, ConnType: &connType}
+	_, httpResp, err := cfg.ApiClient.ConnectionApi.PostConnection(cfg.AuthContext).Connection(conn).Execute()
+	if err != nil && !isConflict(httpResp) {
+		t.Fatalf("failed to create connection %s: %s", connID, err)
+	}
+}
+
+func testAccCheckAirflowConnectionExists(connID string, exists bool) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		cfg, err := testAccProviderConfig()
+		if err != nil {
+			return err
+		}
+		_, httpResp, err := cfg.ApiClient.ConnectionApi.GetConnection(cfg.AuthContext, connID).Execute()
+		switch {
+		case httpResp != nil && httpResp.StatusCode == http.StatusNotFound:
+			if exists {
+				return fmt.Errorf("connection %s does not exist", connID)
+			}
+		case err != nil:
+			return err
+		case !exists:
+			return fmt.Errorf("connection %s still exists", connID)
+		}
+		return nil
+	}
+}
+
+func TestDefaultConnectionMatcher(t *testing.T) {
+	conns := []connectionItem{
+		{ConnectionID: "aws_default", ConnType: "aws"},
+		{ConnectionID: "http_default", ConnType: "http"},
+		{ConnectionID: "my_conn", ConnType: "http"},
+		{ConnectionID: "postgres_default", ConnType: "postgres"},
+		// Retyped, so no longer the example connection.
+		{ConnectionID: "spark_default", ConnType: "spark_connect"},
+	}
+
+	got := matchingConnectionIDs(conns, defaultConnectionMatcher([]string{"postgres_default"}))
+	if want := []string{"aws_default", "http_default"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("defaultConnectionMatcher() matched %v, want %v", got, want)
+	}
+	if got := matchingConnectionIDs(nil, defaultConnectionMatcher(nil)); got == nil || len(got) != 0 {
+		t.Errorf("matchingConnectionIDs(nil) = %#v, want empty", got)
+	}
+}
@@ -50,6 +50,7 @@ type poolResourceModel struct {
 	RunningSlots    types.Int64  `tfsdk:"running_slots"`
 	DeferredSlots   types.Int64  `tfsdk:"deferred_slots"`
 	ScheduledSlots  types.Int64  `tfsdk:"scheduled_slots"`
+	AdoptExisting   types.Bool   `tfsdk:"adopt_existing"`
 }
 
 func (r *poolResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -94,6 +95,12 @@ func (r *poolResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Optional:            true,
 				Computed:            true,
 			},
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Take over the pool if one with the same name already exists when the resource is created, instead of failing. The existing pool is then updated to match the configuration and a warning is reported. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
 			"occupied_slots":  schema.Int64Attribute{MarkdownDescription: "The number of slots used.", Computed: true},
 			"queued_slots":    schema.Int64Attribute{MarkdownDescription: "The number of slots with queued tasks.", Computed: true},
 			"open_slots":      schema.Int64Attribute{MarkdownDescription: "The number of open slots in the pool.", Computed: true},
@@ -153,6 +160,10 @@ func (r *poolResource) Create(ctx context.Context, req resource.CreateRequest, r
 	}
 
 	_, httpResp, err := r.config.ApiClient.PoolApi.PostPool(r.config.AuthContext).Pool(pool).Execute()
+	if err != nil && isConflict(httpResp) && plan.AdoptExisting.ValueBool() {
+		addAdoptedWarning(&resp.Diagnostics, "pool", name)
+		_, httpResp, err = r.config.ApiClient.PoolApi.PatchPool(r.config.AuthContext, name).Pool(pool).Execute()
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to create Airflow pool", clientError("create", name, httpResp, err))
 		return
@@ -269,6 +280,9 @@ func (r *poolResource) readInto(m *poolResourceModel, diags *diag.Diagnostics) (
 	}
 
 	m.Name = types.StringValue(pool.GetName())
+	if m.AdoptExisting.IsNull() {
+		m.AdoptExisting = types.BoolValue(false)
+	}
 	m.Slots = types.Int64Value(int64(pool.GetSlots()))
 	m.IncludeDeferred = types.BoolValue(pool.GetIncludeDeferred())
 	m.TeamName = types.StringValue(pool.GetTeamName())
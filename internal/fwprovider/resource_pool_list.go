@@ -75,6 +75,7 @@ func (r *poolListResource) List(ctx context.Context, req list.ListRequest, strea
 					RunningSlots:    types.Int64Value(int64(p.GetRunningSlots())),
 					DeferredSlots:   types.Int64Value(int64(p.GetDeferredSlots())),
 					ScheduledSlots:  types.Int64Value(int64(p.GetScheduledSlots())),
+					AdoptExisting:   types.BoolValue(false),
 				}
 				if p.Description.IsSet() && p.Description.Get() != nil {
 					m.Description = types.StringValue(*p.Description.Get())
@@ -5,6 +5,7 @@ import (
 	"regexp"
 	"testing"
 
+	"github.com/apache/airflow-client-go/airflow"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
@@ -263,3 +264,40 @@ func TestAccAirflowPool_upgradeFromSDKv2(t *testing.T) {
 		},
 	})
 }
+
+func TestAccAirflowPool_adoptExisting(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "airflow_pool.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckAirflowPoolCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					cfg, err := testAccProviderConfig()
+					if err != nil {
+						t.Fatal(err)
+					}
+					slots := int32(1)
+					pool := airflow.Pool{Name: &rName, Slots: &slots}
+					if _, _, err := cfg.ApiClient.PoolApi.PostPool(cfg.AuthContext).Pool(pool).Execute(); err != nil {
+						t.Fatalf("failed to create pool %s: %s", rName, err)
+					}
+				},
+				Config: fmt.Sprintf(`
+resource "airflow_pool" "test" {
+  name           = %[1]q
+  slots          = 4
+  adopt_existing = true
+}
+`, rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "slots", "4"),
+					resource.TestCheckResourceAttr(resourceName, "adopt_existing", "true"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,276 @@
+package fwprovider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/drfaust92/terraform-provider-airflow/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource              = &taskInstancesClearResource{}
+	_ resource.ResourceWithConfigure = &taskInstancesClearResource{}
+)
+
+func newTaskInstancesClearResource() resource.Resource {
+	return &taskInstancesClearResource{}
+}
+
+type taskInstancesClearResource struct {
+	config client.ProviderConfig
+}
+
+type taskInstancesClearResourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	DagID             types.String `tfsdk:"dag_id"`
+	DagRunID          types.String `tfsdk:"dag_run_id"`
+	StartDate         types.String `tfsdk:"start_date"`
+	EndDate           types.String `tfsdk:"end_date"`
+	TaskIDs           types.List   `tfsdk:"task_ids"`
+	IncludeUpstream   types.Bool   `tfsdk:"include_upstream"`
+	IncludeDownstream types.Bool   `tfsdk:"include_downstream"`
+	OnlyFailed        types.Bool   `tfsdk:"only_failed"`
+	ResetDagRuns      types.Bool   `tfsdk:"reset_dag_runs"`
+	DryRun            types.Bool   `tfsdk:"dry_run"`
+	Triggers          types.Map    `tfsdk:"triggers"`
+	TaskInstances     types.List   `tfsdk:"task_instances"`
+}
+
+var clearedTaskInstanceAttrTypes = map[string]attr.Type{
+	"dag_id":     types.StringType,
+	"dag_run_id": types.StringType,
+	"task_id":    types.StringType,
+}
+
+// clearTaskInstancesBody is the request body of clearTaskInstances, which is
+// the same on API v1 and v2 for the options the resource exposes.
+type clearTaskInstancesBody struct {
+	DryRun            bool     `json:"dry_run"`
+	DagRunID          string   `json:"dag_run_id,omitempty"`
+	StartDate         string   `json:"start_date,omitempty"`
+	EndDate           string   `json:"end_date,omitempty"`
+	TaskIDs           []string `json:"task_ids,omitempty"`
+	OnlyFailed        bool     `json:"only_failed"`
+	ResetDagRuns      bool     `json:"reset_dag_runs"`
+	IncludeUpstream   bool     `json:"include_upstream"`
+	IncludeDownstream bool     `json:"include_downstream"`
+}
+
+func (r *taskInstancesClearResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_task_instances_clear"
+}
+
+func (r *taskInstancesClearResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	boolOption := func(description string, def bool) schema.BoolAttribute {
+		return schema.BoolAttribute{
+			MarkdownDescription: description,
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(def),
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.RequiresReplace(),
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Clears Airflow task instances so the scheduler runs them again, e.g. after fixing a downstream table. The task instances are cleared on create and again whenever any argument or `triggers` change; destroying the resource does nothing in Airflow.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The clear identifier, in the form `dag_id:timestamp`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"dag_id": schema.StringAttribute{
+				MarkdownDescription: "The DAG ID.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"dag_run_id": schema.StringAttribute{
+				MarkdownDescription: "Only clear task instances of this DAG run. Conflicts with `start_date` and `end_date`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("start_date"), path.MatchRoot("end_date")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"start_date": schema.StringAttribute{
+				MarkdownDescription: "Only clear task instances of DAG runs with a logical date at or after this RFC 3339 timestamp.",
+				Optional:            true,
+				Validators: []validator.String{
+					rfc3339Validator{},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"end_date": schema.StringAttribute{
+				MarkdownDescription: "Only clear task instances of DAG runs with a logical date at or before this RFC 3339 timestamp.",
+				Optional:            true,
+				Validators: []validator.String{
+					rfc3339Validator{},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"task_ids": schema.ListAttribute{
+				MarkdownDescription: "Only clear these tasks. All tasks are cleared when unset.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"include_upstream":   boolOption("Also clear the upstream tasks of `task_ids`. Defaults to `false`.", false),
+			"include_downstream": boolOption("Also clear the downstream tasks of `task_ids`. Defaults to `false`.", false),
+			"only_failed":        boolOption("Only clear failed task instances. Defaults to `false`.", false),
+			"reset_dag_runs":     boolOption("Set the DAG runs of the cleared task instances back to queued. Defaults to `true`.", true),
+			"dry_run":            boolOption("Only report which task instances would be cleared, without clearing them. Defaults to `false`.", false),
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary values that clear the task instances again when changed.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"task_instances": schema.ListNestedAttribute{
+				MarkdownDescription: "The task instances that were cleared (or, with `dry_run`, would be cleared).",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"dag_id":     schema.StringAttribute{MarkdownDescription: "The DAG ID.", Computed: true},
+						"dag_run_id": schema.StringAttribute{MarkdownDescription: "The DAG run ID.", Computed: true},
+						"task_id":    schema.StringAttribute{MarkdownDescription: "The task ID.", Computed: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *taskInstancesClearResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	cfg, ok := req.ProviderData.(client.ProviderConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.ProviderConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.config = cfg
+}
+
+func (r *taskInstancesClearResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan taskInstancesClearResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := clearTaskInstancesBody{
+		DryRun:            plan.DryRun.ValueBool(),
+		DagRunID:          plan.DagRunID.ValueString(),
+		StartDate:         plan.StartDate.ValueString(),
+		EndDate:           plan.EndDate.ValueString(),
+		OnlyFailed:        plan.OnlyFailed.ValueBool(),
+		ResetDagRuns:      plan.ResetDagRuns.ValueBool(),
+		IncludeUpstream:   plan.IncludeUpstream.ValueBool(),
+		IncludeDownstream: plan.IncludeDownstream.ValueBool(),
+	}
+	if !plan.TaskIDs.IsNull() && !plan.TaskIDs.IsUnknown() {
+		resp.Diagnostics.Append(plan.TaskIDs.ElementsAs(ctx, &body.TaskIDs, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	dagID := plan.DagID.ValueString()
+	var res struct {
+		TaskInstances []struct {
+			DagID    string `json:"dag_id"`
+			DagRunID string `json:"dag_run_id"`
+			TaskID   string `json:"task_id"`
+		} `json:"task_instances"`
+	}
+	httpResp, err := r.config.Do(ctx, http.MethodPost, fmt.Sprintf("/dags/%s/clearTaskInstances", url.PathEscape(dagID)), nil, body, &res)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to clear Airflow task instances", clientError("clear", dagID, httpResp, err))
+		return
+	}
+
+	values := make([]attr.Value, 0, len(res.TaskInstances))
+	for _, ti := range res.TaskInstances {
+		v, diags := types.ObjectValue(clearedTaskInstanceAttrTypes, map[string]attr.Value{
+			"dag_id":     types.StringValue(ti.DagID),
+			"dag_run_id": types.StringValue(ti.DagRunID),
+			"task_id":    types.StringValue(ti.TaskID),
+		})
+		resp.Diagnostics.Append(diags...)
+		values = append(values, v)
+	}
+	taskInstances, diags := types.ListValue(types.ObjectType{AttrTypes: clearedTaskInstanceAttrTypes}, values)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue(fmt.Sprintf("%s:%s", dagID, time.Now().UTC().Format(time.RFC3339)))
+	plan.TaskInstances = taskInstances
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read keeps the prior state: clearing has no server-side object to refresh.
+func (r *taskInstancesClearResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state taskInstancesClearResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update is never called with changes: every configurable attribute uses
+// RequiresReplace.
+func (r *taskInstancesClearResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan taskInstancesClearResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *taskInstancesClearResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
@@ -0,0 +1,69 @@
+package fwprovider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAirflowTaskInstancesClear_basic(t *testing.T) {
+	if os.Getenv("SKIP_AIRFLOW_DAG_TESTS") == "true" {
+		t.Skip("Skipping Airflow DAG tests")
+	}
+
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "airflow_task_instances_clear.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckAirflowDagRunCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAirflowTaskInstancesClearConfig(rName, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "dag_id", dagId),
+					resource.TestCheckResourceAttr(resourceName, "dry_run", "true"),
+					resource.TestCheckResourceAttr(resourceName, "reset_dag_runs", "true"),
+					resource.TestCheckResourceAttr(resourceName, "task_instances.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "task_instances.0.task_id", "runme_0"),
+					resource.TestCheckResourceAttr(resourceName, "task_instances.0.dag_run_id", rName),
+				),
+			},
+			{
+				Config: testAccAirflowTaskInstancesClearConfig(rName, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "triggers.version", "2"),
+					resource.TestCheckResourceAttr(resourceName, "task_instances.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAirflowTaskInstancesClearConfig(dagRunId, version string) string {
+	return fmt.Sprintf(`
+resource "airflow_dag" "test" {
+  dag_id    = %[1]q
+  is_paused = false
+}
+
+resource "airflow_dag_run" "test" {
+  dag_id     = airflow_dag.test.dag_id
+  dag_run_id = %[2]q
+}
+
+resource "airflow_task_instances_clear" "test" {
+  dag_id     = airflow_dag_run.test.dag_id
+  dag_run_id = airflow_dag_run.test.dag_run_id
+  task_ids   = ["runme_0"]
+  dry_run    = true
+
+  triggers = {
+    version = %[3]q
+  }
+}
+`, dagId, dagRunId, version)
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -54,6 +55,7 @@ type variableResourceModel struct {
 	ValueWOVersion types.String `tfsdk:"value_wo_version"`
 	Description    types.String `tfsdk:"description"`
 	TeamName       types.String `tfsdk:"team_name"`
+	AdoptExisting  types.Bool   `tfsdk:"adopt_existing"`
 }
 
 func (r *variableResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -112,6 +114,12 @@ func (r *variableResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				Optional:            true,
 				Computed:            true,
 			},
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Take over the variable if one with the same key already exists when the resource is created, instead of failing. The existing variable is then updated to match the configuration and a warning is reported. Airflow 2 overwrites existing variables on create regardless. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
 		},
 	}
 }
@@ -191,6 +199,10 @@ func (r *variableResource) Create(ctx context.Context, req resource.CreateReques
 	}
 
 	_, httpResp, err := r.config.ApiClient.VariableApi.PostVariables(r.config.AuthContext).Variable(variableReq).Execute()
+	if err != nil && isConflict(httpResp) && plan.AdoptExisting.ValueBool() {
+		addAdoptedWarning(&resp.Diagnostics, "variable", key)
+		_, httpResp, err = r.config.ApiClient.VariableApi.PatchVariable(r.config.AuthContext, key).Variable(variableReq).Execute()
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to create Airflow variable", clientError("create", key, httpResp, err))
 		return
@@ -308,6 +320,9 @@ func (r *variableResource) readInto(m *variableResourceModel, diags *diag.Diagno
 	}
 
 	m.Key = types.StringValue(variable.GetKey())
+	if m.AdoptExisting.IsNull() {
+		m.AdoptExisting = types.BoolValue(false)
+	}
 	// In write-only mode (value_wo_version set) the value came from the
 	// write-only value_wo and must not be persisted to state, so skip reading it
 	// back from the API. Otherwise refresh value from the API as before.
@@ -364,15 +379,35 @@ func clientError(op, id string, httpResp *http.Response, err error) string {
 	return msg
 }
 
+// isConflict reports whether a create call failed because the object already
+// exists.
+func isConflict(httpResp *http.Response) bool {
+	return httpResp != nil && httpResp.StatusCode == http.StatusConflict
+}
+
+// addAdoptedWarning reports that Create took over an existing object, as
+// allowed by adopt_existing, instead of creating it.
+func addAdoptedWarning(diags *diag.Diagnostics, kind, id string) {
+	diags.AddWarning(
+		fmt.Sprintf("Adopted existing Airflow %s", kind),
+		fmt.Sprintf("The %s %q already existed and adopt_existing is set, so it was updated to match the configuration and is now managed by Terraform. Destroying the resource deletes it.", kind, id),
+	)
+}
+
 // apiErrorDetail extracts Airflow's error message from a client error. The
-// generated client's error string is only the HTTP status; the useful message
-// (RFC 7807 problem detail) is in the response body. Returns "" when absent.
+// generated client's error string (and client.Do's) is only the HTTP status;
+// the useful message (RFC 7807 problem detail) is in the response body.
+// Returns "" when absent.
 func apiErrorDetail(err error) string {
 	var apiErr *airflow.GenericOpenAPIError
-	if !errors.As(err, &apiErr) {
-		return ""
+	if errors.As(err, &apiErr) {
+		return problemDetail(apiErr.Body())
+	}
+	var rawErr *client.APIError
+	if errors.As(err, &rawErr) {
+		return problemDetail(rawErr.Body())
 	}
-	return problemDetail(apiErr.Body())
+	return ""
 }
 
 // problemDetail returns the human-readable message from an Airflow error
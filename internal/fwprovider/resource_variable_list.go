@@ -73,10 +73,11 @@ func (r *variableListResource) List(ctx context.Context, req list.ListRequest, s
 					result.Diagnostics.AddError("Failed to read Airflow variable", clientError("read", v.GetKey(), fHTTP, fErr))
 				} else {
 					result.Diagnostics.Append(result.Resource.Set(ctx, variableResourceModel{
-						ID:          types.StringValue(full.GetKey()),
-						Key:         types.StringValue(full.GetKey()),
-						Value:       types.StringValue(full.GetValue()),
-						Description: types.StringValue(full.GetDescription()),
+						ID:            types.StringValue(full.GetKey()),
+						Key:           types.StringValue(full.GetKey()),
+						Value:         types.StringValue(full.GetValue()),
+						Description:   types.StringValue(full.GetDescription()),
+						AdoptExisting: types.BoolValue(false),
 					})...)
 				}
 			}
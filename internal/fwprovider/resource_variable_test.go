@@ -7,6 +7,7 @@ import (
 	"regexp"
 	"testing"
 
+	"github.com/apache/airflow-client-go/airflow"
 	"github.com/drfaust92/terraform-provider-airflow/internal/client"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -157,6 +158,43 @@ func TestAccAirflowVariable_maskedJSONSecret(t *testing.T) {
 	})
 }
 
+func TestAccAirflowVariable_adoptExisting(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "airflow_variable.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckAirflowVariableCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					cfg, err := testAccProviderConfig()
+					if err != nil {
+						t.Fatal(err)
+					}
+					value := "existing"
+					variable := airflow.Variable{Key: &rName, Value: &value}
+					if _, _, err := cfg.ApiClient.VariableApi.PostVariables(cfg.AuthContext).Variable(variable).Execute(); err != nil {
+						t.Fatalf("failed to create variable %s: %s", rName, err)
+					}
+				},
+				Config: fmt.Sprintf(`
+resource "airflow_variable" "test" {
+  key            = %[1]q
+  value          = "adopted"
+  adopt_existing = true
+}
+`, rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "value", "adopted"),
+					resource.TestCheckResourceAttr(resourceName, "adopt_existing", "true"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckAirflowVariableCheckDestroy(s *terraform.State) error {
 	cfg, err := testAccProviderConfig()
 	if err != nil {
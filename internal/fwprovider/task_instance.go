@@ -0,0 +1,167 @@
+package fwprovider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/drfaust92/terraform-provider-airflow/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// taskInstanceModel holds the task instance attributes shared by the
+// airflow_task_instance and airflow_task_instances data sources.
+type taskInstanceModel struct {
+	DagID          types.String  `tfsdk:"dag_id"`
+	DagRunID       types.String  `tfsdk:"dag_run_id"`
+	TaskID         types.String  `tfsdk:"task_id"`
+	MapIndex       types.Int64   `tfsdk:"map_index"`
+	LogicalDate    types.String  `tfsdk:"logical_date"`
+	State          types.String  `tfsdk:"state"`
+	TryNumber      types.Int64   `tfsdk:"try_number"`
+	MaxTries       types.Int64   `tfsdk:"max_tries"`
+	StartDate      types.String  `tfsdk:"start_date"`
+	EndDate        types.String  `tfsdk:"end_date"`
+	Duration       types.Float64 `tfsdk:"duration"`
+	Hostname       types.String  `tfsdk:"hostname"`
+	Operator       types.String  `tfsdk:"operator"`
+	Pool           types.String  `tfsdk:"pool"`
+	Queue          types.String  `tfsdk:"queue"`
+	RenderedFields types.String  `tfsdk:"rendered_fields"`
+}
+
+var taskInstanceAttrTypes = map[string]attr.Type{
+	"dag_id":          types.StringType,
+	"dag_run_id":      types.StringType,
+	"task_id":         types.StringType,
+	"map_index":       types.Int64Type,
+	"logical_date":    types.StringType,
+	"state":           types.StringType,
+	"try_number":      types.Int64Type,
+	"max_tries":       types.Int64Type,
+	"start_date":      types.StringType,
+	"end_date":        types.StringType,
+	"duration":        types.Float64Type,
+	"hostname":        types.StringType,
+	"operator":        types.StringType,
+	"pool":            types.StringType,
+	"queue":           types.StringType,
+	"rendered_fields": types.StringType,
+}
+
+// taskInstanceSchemaAttributes returns the computed data source attributes of
+// taskInstanceModel.
+func taskInstanceSchemaAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"dag_id":          schema.StringAttribute{MarkdownDescription: "The DAG ID.", Computed: true},
+		"dag_run_id":      schema.StringAttribute{MarkdownDescription: "The DAG run ID.", Computed: true},
+		"task_id":         schema.StringAttribute{MarkdownDescription: "The task ID.", Computed: true},
+		"map_index":       schema.Int64Attribute{MarkdownDescription: "The map index of a mapped task instance, `-1` for unmapped tasks.", Computed: true},
+		"logical_date":    schema.StringAttribute{MarkdownDescription: "The logical (execution) date of the DAG run.", Computed: true},
+		"state":           schema.StringAttribute{MarkdownDescription: "The task instance state, empty if it has none yet.", Computed: true},
+		"try_number":      schema.Int64Attribute{MarkdownDescription: "The current try number.", Computed: true},
+		"max_tries":       schema.Int64Attribute{MarkdownDescription: "The maximum number of tries.", Computed: true},
+		"start_date":      schema.StringAttribute{MarkdownDescription: "When the task instance started.", Computed: true},
+		"end_date":        schema.StringAttribute{MarkdownDescription: "When the task instance ended.", Computed: true},
+		"duration":        schema.Float64Attribute{MarkdownDescription: "The run time in seconds.", Computed: true},
+		"hostname":        schema.StringAttribute{MarkdownDescription: "The host the task instance ran on.", Computed: true},
+		"operator":        schema.StringAttribute{MarkdownDescription: "The operator class of the task.", Computed: true},
+		"pool":            schema.StringAttribute{MarkdownDescription: "The pool of the task instance.", Computed: true},
+		"queue":           schema.StringAttribute{MarkdownDescription: "The queue of the task instance.", Computed: true},
+		"rendered_fields": schema.StringAttribute{MarkdownDescription: "The JSON-encoded rendered template fields.", Computed: true},
+	}
+}
+
+// taskInstance is a task instance as returned by API v1 and v2. Fields
+// renamed in API v2 are listed under both names.
+type taskInstance struct {
+	DagID          string                 `json:"dag_id"`
+	DagRunID       string                 `json:"dag_run_id"`
+	TaskID         string                 `json:"task_id"`
+	MapIndex       int64                  `json:"map_index"`
+	ExecutionDate  string                 `json:"execution_date"`
+	LogicalDate    *string                `json:"logical_date"`
+	State          *string                `json:"state"`
+	TryNumber      int64                  `json:"try_number"`
+	MaxTries       int64                  `json:"max_tries"`
+	StartDate      *string                `json:"start_date"`
+	EndDate        *string                `json:"end_date"`
+	Duration       *float64               `json:"duration"`
+	Hostname       string                 `json:"hostname"`
+	Operator       *string                `json:"operator"`
+	Pool           string                 `json:"pool"`
+	Queue          *string                `json:"queue"`
+	RenderedFields map[string]interface{} `json:"rendered_fields"`
+}
+
+func taskInstancePath(dagID, dagRunID string) string {
+	return fmt.Sprintf("/dags/%s/dagRuns/%s/taskInstances", url.PathEscape(dagID), url.PathEscape(dagRunID))
+}
+
+// getTaskInstance fetches a task instance; mapIndex is ignored when negative.
+func getTaskInstance(ctx context.Context, cfg client.ProviderConfig, dagID, dagRunID, taskID string, mapIndex int64) (*taskInstance, *http.Response, error) {
+	p := taskInstancePath(dagID, dagRunID) + "/" + url.PathEscape(taskID)
+	if mapIndex >= 0 {
+		p += "/" + strconv.FormatInt(mapIndex, 10)
+	}
+
+	var ti taskInstance
+	httpResp, err := cfg.Do(ctx, http.MethodGet, p, nil, nil, &ti)
+	if err != nil {
+		return nil, httpResp, err
+	}
+	return &ti, httpResp, nil
+}
+
+// listTaskInstances pages through the task instances of a DAG run matching
+// query. dagID and dagRunID may be "~" to list across all DAGs or runs.
+func listTaskInstances(ctx context.Context, cfg client.ProviderConfig, dagID, dagRunID string, query url.Values) ([]taskInstance, *http.Response, error) {
+	return listPages[taskInstance](ctx, cfg, taskInstancePath(dagID, dagRunID), query, "task_instances", nil, 0)
+}
+
+// toModel converts the task instance into its Terraform attribute values.
+func (ti *taskInstance) toModel(diags *diag.Diagnostics) taskInstanceModel {
+	logicalDate := ti.ExecutionDate
+	if ti.LogicalDate != nil {
+		logicalDate = *ti.LogicalDate
+	}
+
+	duration := types.Float64Null()
+	if ti.Duration != nil {
+		duration = types.Float64Value(*ti.Duration)
+	}
+
+	rendered := ""
+	if len(ti.RenderedFields) > 0 {
+		b, err := json.Marshal(ti.RenderedFields)
+		if err != nil {
+			diags.AddError("Failed to encode Airflow task instance rendered fields", err.Error())
+		}
+		rendered = string(b)
+	}
+
+	return taskInstanceModel{
+		DagID:          types.StringValue(ti.DagID),
+		DagRunID:       types.StringValue(ti.DagRunID),
+		TaskID:         types.StringValue(ti.TaskID),
+		MapIndex:       types.Int64Value(ti.MapIndex),
+		LogicalDate:    types.StringValue(logicalDate),
+		State:          types.StringValue(derefString(ti.State)),
+		TryNumber:      types.Int64Value(ti.TryNumber),
+		MaxTries:       types.Int64Value(ti.MaxTries),
+		StartDate:      types.StringValue(derefString(ti.StartDate)),
+		EndDate:        types.StringValue(derefString(ti.EndDate)),
+		Duration:       duration,
+		Hostname:       types.StringValue(ti.Hostname),
+		Operator:       types.StringValue(derefString(ti.Operator)),
+		Pool:           types.StringValue(ti.Pool),
+		Queue:          types.StringValue(derefString(ti.Queue)),
+		RenderedFields: types.StringValue(rendered),
+	}
+}
@@ -0,0 +1,38 @@
+package fwprovider
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+func TestTaskInstanceToModel(t *testing.T) {
+	cases := []struct {
+		name, body, wantLogicalDate string
+	}{
+		{"v1 execution_date", `{"task_id":"t","execution_date":"2026-01-01T00:00:00Z","duration":1.5,"operator":"BashOperator"}`, "2026-01-01T00:00:00Z"},
+		{"v2 logical_date", `{"task_id":"t","logical_date":"2026-01-02T00:00:00Z","duration":1.5,"operator":"BashOperator"}`, "2026-01-02T00:00:00Z"},
+	}
+	for _, c := range cases {
+		var ti taskInstance
+		if err := json.Unmarshal([]byte(c.body), &ti); err != nil {
+			t.Fatalf("%s: %s", c.name, err)
+		}
+
+		var diags diag.Diagnostics
+		m := ti.toModel(&diags)
+		if diags.HasError() {
+			t.Fatalf("%s: toModel diagnostics: %+v", c.name, diags)
+		}
+		if got := m.LogicalDate.ValueString(); got != c.wantLogicalDate {
+			t.Errorf("%s: logical_date = %q, want %q", c.name, got, c.wantLogicalDate)
+		}
+		if got := m.Duration.ValueFloat64(); got != 1.5 {
+			t.Errorf("%s: duration = %v, want 1.5", c.name, got)
+		}
+		if got := m.State.ValueString(); got != "" {
+			t.Errorf("%s: state = %q, want empty", c.name, got)
+		}
+	}
+}
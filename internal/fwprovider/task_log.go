@@ -0,0 +1,255 @@
+package fwprovider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/drfaust92/terraform-provider-airflow/internal/client"
+)
+
+// maxTaskLogPages bounds how many continuation tokens are followed for a
+// single log, in case Airflow keeps handing out tokens for a running task.
+const maxTaskLogPages = 100
+
+// taskLogPage is one page of the task instance logs endpoint. Content is a
+// string on API v1 and a list of log lines (strings or structured messages)
+// on API v2.
+type taskLogPage struct {
+	ContinuationToken string          `json:"continuation_token"`
+	Content           json.RawMessage `json:"content"`
+}
+
+// getTaskLog fetches the log of a task instance try, following continuation
+// tokens until Airflow returns no new content.
+func getTaskLog(ctx context.Context, cfg client.ProviderConfig, dagID, dagRunID, taskID string, tryNumber, mapIndex int64, fullContent bool) (string, *http.Response, error) {
+	p := fmt.Sprintf("%s/%s/logs/%d", taskInstancePath(dagID, dagRunID), url.PathEscape(taskID), tryNumber)
+
+	var (
+		parts    []string
+		httpResp *http.Response
+		token    string
+	)
+	for i := 0; i < maxTaskLogPages; i++ {
+		query := url.Values{}
+		query.Set("full_content", strconv.FormatBool(fullContent))
+		if mapIndex >= 0 {
+			query.Set("map_index", strconv.FormatInt(mapIndex, 10))
+		}
+		if token != "" {
+			query.Set("token", token)
+		}
+
+		var page taskLogPage
+		var err error
+		httpResp, err = cfg.Do(ctx, http.MethodGet, p, query, nil, &page)
+		if err != nil {
+			return "", httpResp, err
+		}
+
+		content, err := decodeTaskLogContent(page.Content)
+		if err != nil {
+			return "", httpResp, err
+		}
+		if content != "" {
+			parts = append(parts, content)
+		}
+
+		if content == "" || page.ContinuationToken == "" || page.ContinuationToken == token {
+			break
+		}
+		token = page.ContinuationToken
+	}
+
+	return strings.Join(parts, "\n"), httpResp, nil
+}
+
+// decodeTaskLogContent turns the content of a log page into plain text.
+func decodeTaskLogContent(raw json.RawMessage) (string, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return "", nil
+	}
+
+	// API v1: a string, which Airflow 2 renders as the Python representation
+	// of a list of (host, log) tuples.
+	var s string
+	if json.Unmarshal(raw, &s) == nil {
+		if text, ok := parseLegacyTaskLog(s); ok {
+			return text, nil
+		}
+		return s, nil
+	}
+
+	// API v2: a list of plain lines or structured log messages.
+	var items []json.RawMessage
+	if err := json.Unmarshal(raw, &items); err != nil {
+		return "", fmt.Errorf("unexpected task log content: %w", err)
+	}
+
+	lines := make([]string, 0, len(items))
+	for _, item := range items {
+		var line string
+		if json.Unmarshal(item, &line) == nil {
+			lines = append(lines, line)
+			continue
+		}
+
+		var msg struct {
+			Timestamp string `json:"timestamp"`
+			Level     string `json:"level"`
+			Event     string `json:"event"`
+		}
+		if err := json.Unmarshal(item, &msg); err != nil {
+			return "", fmt.Errorf("unexpected task log line: %w", err)
+		}
+		lines = append(lines, formatStructuredLogLine(msg.Timestamp, msg.Level, msg.Event))
+	}
+	return strings.Join(lines, "\n"), nil
+}
+
+func formatStructuredLogLine(timestamp, level, event string) string {
+	var b strings.Builder
+	if timestamp != "" {
+		b.WriteString("[" + timestamp + "] ")
+	}
+	if level != "" {
+		b.WriteString(strings.ToUpper(level) + " - ")
+	}
+	b.WriteString(event)
+	return b.String()
+}
+
+// tailLines returns the last n lines of s.
+func tailLines(s string, n int) string {
+	lines := strings.Split(strings.TrimRight(s, "\n"), "\n")
+	if n >= len(lines) {
+		return strings.Join(lines, "\n")
+	}
+	return strings.Join(lines[len(lines)-n:], "\n")
+}
+
+// parseLegacyTaskLog parses the Python representation of a list of
+// (host, log) tuples, e.g. `[('worker-1', '*** Reading local file...\n')]`,
+// and returns the concatenated logs. ok is false if s is not in that form.
+func parseLegacyTaskLog(s string) (text string, ok bool) {
+	p := &pyReprParser{s: strings.TrimSpace(s)}
+	if !p.consume('[') {
+		return "", false
+	}
+
+	var logs []string
+	for {
+		p.skipSpace()
+		if p.consume(']') {
+			break
+		}
+		if !p.consume('(') {
+			return "", false
+		}
+		if _, ok := p.str(); !ok {
+			return "", false
+		}
+		p.skipSpace()
+		if !p.consume(',') {
+			return "", false
+		}
+		p.skipSpace()
+		log, ok := p.str()
+		if !ok {
+			return "", false
+		}
+		p.skipSpace()
+		if !p.consume(')') {
+			return "", false
+		}
+		logs = append(logs, log)
+		p.skipSpace()
+		p.consume(',')
+	}
+
+	p.skipSpace()
+	if p.pos != len(p.s) {
+		return "", false
+	}
+	return strings.Join(logs, "\n"), true
+}
+
+// pyReprParser reads Python string literals as produced by repr().
+type pyReprParser struct {
+	s   string
+	pos int
+}
+
+func (p *pyReprParser) skipSpace() {
+	for p.pos < len(p.s) && (p.s[p.pos] == ' ' || p.s[p.pos] == '\n') {
+		p.pos++
+	}
+}
+
+func (p *pyReprParser) consume(c byte) bool {
+	if p.pos < len(p.s) && p.s[p.pos] == c {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *pyReprParser) str() (string, bool) {
+	if p.pos >= len(p.s) || (p.s[p.pos] != '\'' && p.s[p.pos] != '"') {
+		return "", false
+	}
+	quote := p.s[p.pos]
+	p.pos++
+
+	var b strings.Builder
+	for p.pos < len(p.s) {
+		c := p.s[p.pos]
+		switch {
+		case c == quote:
+			p.pos++
+			return b.String(), true
+		case c != '\\':
+			b.WriteByte(c)
+			p.pos++
+			continue
+		}
+
+		// Backslash escape.
+		p.pos++
+		if p.pos >= len(p.s) {
+			return "", false
+		}
+		e := p.s[p.pos]
+		p.pos++
+		switch e {
+		case 'n':
+			b.WriteByte('\n')
+		case 't':
+			b.WriteByte('\t')
+		case 'r':
+			b.WriteByte('\r')
+		case '\\', '\'', '"':
+			b.WriteByte(e)
+		case 'x', 'u', 'U':
+			width := map[byte]int{'x': 2, 'u': 4, 'U': 8}[e]
+			if p.pos+width > len(p.s) {
+				return "", false
+			}
+			r, err := strconv.ParseUint(p.s[p.pos:p.pos+width], 16, 32)
+			if err != nil || !utf8.ValidRune(rune(r)) {
+				return "", false
+			}
+			b.WriteRune(rune(r))
+			p.pos += width
+		default:
+			b.WriteByte('\\')
+			b.WriteByte(e)
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,51 @@
+package fwprovider
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestDecodeTaskLogContent(t *testing.T) {
+	cases := []struct {
+		name, raw, want string
+	}{
+		{"v1 legacy tuples", `"[('worker-1', '*** Reading local file\\nline \\'2\\'\\n')]"`, "*** Reading local file\nline '2'\n"},
+		{"v1 legacy multiple hosts", `"[('a', 'one'), (\"b\", \"it's two\")]"`, "one\nit's two"},
+		{"v1 plain text", `"just text"`, "just text"},
+		{"v2 structured", `[{"timestamp":"2026-01-01T00:00:00Z","level":"info","event":"hello"},{"event":"::group::Log"}]`, "[2026-01-01T00:00:00Z] INFO - hello\n::group::Log"},
+		{"v2 plain lines", `["a","b"]`, "a\nb"},
+		{"empty", `null`, ""},
+	}
+	for _, c := range cases {
+		got, err := decodeTaskLogContent(json.RawMessage(c.raw))
+		if err != nil {
+			t.Fatalf("%s: %s", c.name, err)
+		}
+		if got != c.want {
+			t.Errorf("%s: content = %q, want %q", c.name, got, c.want)
+		}
+	}
+}
+
+func TestParseLegacyTaskLogEscapes(t *testing.T) {
+	got, ok := parseLegacyTaskLog(`[('h', 'caf\xe9 ✓ \\path')]`)
+	if !ok {
+		t.Fatal("parseLegacyTaskLog() failed")
+	}
+	if want := "café ✓ \\path"; got != want {
+		t.Errorf("parseLegacyTaskLog() = %q, want %q", got, want)
+	}
+
+	if _, ok := parseLegacyTaskLog(`[('h', 'unterminated)]`); ok {
+		t.Error("parseLegacyTaskLog() accepted an unterminated string")
+	}
+}
+
+func TestTailLines(t *testing.T) {
+	if got := tailLines("a\nb\nc\n", 2); got != "b\nc" {
+		t.Errorf("tailLines() = %q", got)
+	}
+	if got := tailLines("a\nb", 5); got != "a\nb" {
+		t.Errorf("tailLines() = %q", got)
+	}
+}
@@ -0,0 +1,87 @@
+package fwprovider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/drfaust92/terraform-provider-airflow/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// xcomEntry is an XCom entry as returned by API v1 and v2. Value is only set
+// when fetching a single entry.
+type xcomEntry struct {
+	DagID     string          `json:"dag_id"`
+	RunID     string          `json:"run_id"`
+	TaskID    string          `json:"task_id"`
+	Key       string          `json:"key"`
+	MapIndex  int64           `json:"map_index"`
+	Timestamp string          `json:"timestamp"`
+	Value     json.RawMessage `json:"value"`
+}
+
+func xcomEntriesPath(dagID, dagRunID, taskID string) string {
+	return fmt.Sprintf("%s/%s/xcomEntries", taskInstancePath(dagID, dagRunID), url.PathEscape(taskID))
+}
+
+// getXCom fetches an XCom entry with its value deserialized where possible,
+// so JSON-native values come back as JSON rather than their Python string
+// representation. API v2 always supports the deserialize and stringify
+// options. API v1 rejects them with 400 unless `[api]
+// enable_xcom_deserialize_support` is enabled, in which case the entry is
+// fetched again without them.
+func getXCom(ctx context.Context, cfg client.ProviderConfig, dagID, dagRunID, taskID, key string, mapIndex int64) (*xcomEntry, *http.Response, error) {
+	path := xcomEntriesPath(dagID, dagRunID, taskID) + "/" + url.PathEscape(key)
+	query := url.Values{}
+	query.Set("map_index", strconv.FormatInt(mapIndex, 10))
+
+	deserialize := cloneValues(query)
+	deserialize.Set("deserialize", "true")
+	deserialize.Set("stringify", "false")
+
+	var entry xcomEntry
+	httpResp, err := cfg.Do(ctx, http.MethodGet, path, deserialize, nil, &entry)
+	if err != nil && !cfg.IsV2() && httpResp != nil && httpResp.StatusCode == http.StatusBadRequest {
+		httpResp, err = cfg.Do(ctx, http.MethodGet, path, query, nil, &entry)
+	}
+	if err != nil {
+		return nil, httpResp, err
+	}
+	return &entry, httpResp, nil
+}
+
+// listXComs pages through the XCom entries of a task instance matching query.
+// dagID, dagRunID and taskID may be "~" to list across all of them.
+func listXComs(ctx context.Context, cfg client.ProviderConfig, dagID, dagRunID, taskID string, query url.Values) ([]xcomEntry, *http.Response, error) {
+	return listPages[xcomEntry](ctx, cfg, xcomEntriesPath(dagID, dagRunID, taskID), query, "xcom_entries", nil, 0)
+}
+
+// xcomValue returns an XCom value as a string and as a dynamic value parsed
+// from JSON. A string value is returned as is, and is parsed too when it holds
+// JSON; otherwise (e.g. the Python representation returned by Airflow versions
+// that cannot deserialize XComs) the dynamic value is that string.
+func xcomValue(ctx context.Context, raw json.RawMessage) (types.String, types.Dynamic) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return types.StringNull(), types.DynamicNull()
+	}
+
+	var s string
+	if json.Unmarshal(raw, &s) != nil {
+		parsed, err := jsonToDynamic(ctx, raw)
+		if err != nil {
+			return types.StringValue(string(raw)), types.DynamicNull()
+		}
+		return types.StringValue(string(raw)), parsed
+	}
+
+	if json.Valid([]byte(s)) {
+		if parsed, err := jsonToDynamic(ctx, []byte(s)); err == nil {
+			return types.StringValue(s), parsed
+		}
+	}
+	return types.StringValue(s), types.DynamicValue(types.StringValue(s))
+}
@@ -0,0 +1,114 @@
+package fwprovider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/drfaust92/terraform-provider-airflow/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestXComValue(t *testing.T) {
+	ctx := context.Background()
+	cases := []struct {
+		name, raw, wantValue string
+		wantJSON             string // Terraform type of value_json, "" for null
+	}{
+		{"plain string", `"bucket-1"`, "bucket-1", "basetypes.StringType"},
+		{"string holding JSON", `"{\"host\":\"db\"}"`, `{"host":"db"}`, "types.ObjectType[\"host\":basetypes.StringType]"},
+		{"python repr", `"{'host': 'db'}"`, "{'host': 'db'}", "basetypes.StringType"},
+		{"native object", `{"host":"db","port":5432}`, `{"host":"db","port":5432}`, "types.ObjectType[\"host\":basetypes.StringType, \"port\":basetypes.NumberType]"},
+		{"native list", `[1,"a",null]`, `[1,"a",null]`, "types.TupleType[basetypes.NumberType, basetypes.StringType, basetypes.StringType]"},
+		{"null", `null`, "", ""},
+	}
+	for _, c := range cases {
+		value, valueJSON := xcomValue(ctx, []byte(c.raw))
+		if got := value.ValueString(); got != c.wantValue {
+			t.Errorf("%s: value = %q, want %q", c.name, got, c.wantValue)
+		}
+		if c.wantJSON == "" {
+			if !valueJSON.IsNull() {
+				t.Errorf("%s: value_json = %s, want null", c.name, valueJSON)
+			}
+			continue
+		}
+		if got := valueJSON.UnderlyingValue().Type(ctx).String(); got != c.wantJSON {
+			t.Errorf("%s: value_json type = %s, want %s", c.name, got, c.wantJSON)
+		}
+	}
+}
+
+func TestJSONToDynamicNumber(t *testing.T) {
+	v, err := jsonToDynamic(context.Background(), []byte(`12345678901234567890`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	n, ok := v.UnderlyingValue().(types.Number)
+	if !ok {
+		t.Fatalf("value_json = %T, want types.Number", v.UnderlyingValue())
+	}
+	if got := n.ValueBigFloat().Text('f', 0); got != "12345678901234567890" {
+		t.Errorf("number = %s, want 12345678901234567890", got)
+	}
+}
+
+func TestDynamicToJSONRoundTrip(t *testing.T) {
+	raw := `{"a":[1,"b",true,null],"c":{"d":1.5}}`
+	v, err := jsonToDynamic(context.Background(), []byte(raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := dynamicToJSON(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !jsonSemanticEqual(string(got), raw) {
+		t.Errorf("dynamicToJSON = %s, want %s", got, raw)
+	}
+}
+
+func TestGetXComDeserializeFallback(t *testing.T) {
+	for _, basePath := range []string{"/api/v1", "/api/v2"} {
+		var queries []url.Values
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			queries = append(queries, req.URL.Query())
+			// API v1 without [api] enable_xcom_deserialize_support.
+			if basePath == "/api/v1" && req.URL.Query().Has("deserialize") {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"key":"k","value":"v"}`))
+		}))
+
+		cfg, err := client.NewProviderConfig(srv.URL, "", "", "", false, basePath, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		entry, _, err := getXCom(context.Background(), cfg, "d", "r", "t", "k", -1)
+		srv.Close()
+		if err != nil {
+			t.Fatalf("%s: %s", basePath, err)
+		}
+		if string(entry.Value) != `"v"` {
+			t.Errorf("%s: value = %s", basePath, entry.Value)
+		}
+
+		wantRequests := 1
+		if basePath == "/api/v1" {
+			wantRequests = 2
+		}
+		if len(queries) != wantRequests {
+			t.Fatalf("%s: %d requests, want %d", basePath, len(queries), wantRequests)
+		}
+		if !queries[0].Has("deserialize") || queries[len(queries)-1].Get("map_index") != "-1" {
+			t.Errorf("%s: unexpected queries %v", basePath, queries)
+		}
+		if basePath == "/api/v1" && queries[1].Has("deserialize") {
+			t.Errorf("fallback request still sends deserialize: %v", queries[1])
+		}
+	}
+}